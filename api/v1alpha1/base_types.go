@@ -107,6 +107,11 @@ type ContainerTemplate struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
 	StartupProbe *Probe `json:"startupProbe,omitempty"`
+	// Lifecycle defines the actions that the management system should take in response to container lifecycle events.
+	// It may be used to define a preStop hook to gracefully shutdown the container before it gets terminated.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
 	// Resouces describes the compute resource requirements.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
@@ -296,6 +301,17 @@ type PodTemplate struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
 	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// TopologySpreadZoneEnabled configures a TopologySpreadConstraint so Pods are evenly spread across zones, enabling multi-AZ HA.
+	// It only takes effect when no TopologySpreadConstraints are provided. Make sure you have Nodes available in enough zones to not end up with unscheduled Pods.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	TopologySpreadZoneEnabled *bool `json:"topologySpreadZoneEnabled,omitempty"`
+	// TopologySpreadHostnameEnabled configures a TopologySpreadConstraint so Pods are spread across Nodes whenever possible.
+	// It only takes effect when neither TopologySpreadConstraints nor PodAntiAffinity are provided.
+	// It defaults to true when creating a MariaDB with Galera enabled. Existing MariaDBs are not defaulted, as it would trigger a rolling update.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	TopologySpreadHostnameEnabled *bool `json:"topologySpreadHostnameEnabled,omitempty"`
 }
 
 // SetDefaults sets reasonable defaults.
@@ -308,6 +324,62 @@ func (p *PodTemplate) SetDefaults(objMeta metav1.ObjectMeta) {
 	}
 }
 
+// TopologySpreadConstraintsOrDefault returns the TopologySpreadConstraints to be used in the Pod.
+// The default constraints are not persisted in the spec, so they can be disabled afterwards by toggling the corresponding flags.
+func (p *PodTemplate) TopologySpreadConstraintsOrDefault(objMeta metav1.ObjectMeta) []TopologySpreadConstraint {
+	if len(p.TopologySpreadConstraints) > 0 {
+		return p.TopologySpreadConstraints
+	}
+	if ptr.Deref(p.TopologySpreadZoneEnabled, false) {
+		return []TopologySpreadConstraint{
+			ZoneTopologySpreadConstraint(objMeta),
+		}
+	}
+	if ptr.Deref(p.TopologySpreadHostnameEnabled, false) && !p.hasPodAntiAffinity() {
+		return []TopologySpreadConstraint{
+			HostnameTopologySpreadConstraint(objMeta),
+		}
+	}
+	return p.TopologySpreadConstraints
+}
+
+func (p *PodTemplate) hasPodAntiAffinity() bool {
+	if p.Affinity == nil {
+		return false
+	}
+	return ptr.Deref(p.Affinity.AntiAffinityEnabled, false) || p.Affinity.PodAntiAffinity != nil
+}
+
+// ZoneTopologySpreadConstraint returns a TopologySpreadConstraint that evenly spreads the Pods of an instance across zones.
+func ZoneTopologySpreadConstraint(objMeta metav1.ObjectMeta) TopologySpreadConstraint {
+	return TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app.kubernetes.io/name":     "mariadb",
+				"app.kubernetes.io/instance": objMeta.Name,
+			},
+		},
+	}
+}
+
+// HostnameTopologySpreadConstraint returns a TopologySpreadConstraint that spreads the Pods of an instance across Nodes whenever possible.
+func HostnameTopologySpreadConstraint(objMeta metav1.ObjectMeta) TopologySpreadConstraint {
+	return TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelHostname,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app.kubernetes.io/name":     "mariadb",
+				"app.kubernetes.io/instance": objMeta.Name,
+			},
+		},
+	}
+}
+
 // ServiceAccountKey defines the key for the ServiceAccount object.
 func (p *PodTemplate) ServiceAccountKey(objMeta metav1.ObjectMeta) types.NamespacedName {
 	return types.NamespacedName{
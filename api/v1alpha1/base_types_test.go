@@ -527,6 +527,192 @@ var _ = Describe("Base types", func() {
 		)
 	})
 
+	Context("When creating a PodTemplate object", func() {
+		objMeta := metav1.ObjectMeta{
+			Name:      "mariadb-zones",
+			Namespace: testNamespace,
+		}
+		zoneConstraint := TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/name":     "mariadb",
+					"app.kubernetes.io/instance": "mariadb-zones",
+				},
+			},
+		}
+		hostnameConstraint := TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       "kubernetes.io/hostname",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/name":     "mariadb",
+					"app.kubernetes.io/instance": "mariadb-zones",
+				},
+			},
+		}
+		DescribeTable(
+			"Should get topology spread constraints",
+			func(
+				podTpl *PodTemplate,
+				wantConstraints []TopologySpreadConstraint,
+			) {
+				podTpl.SetDefaults(objMeta)
+				Expect(podTpl.TopologySpreadConstraintsOrDefault(objMeta)).To(BeEquivalentTo(wantConstraints))
+			},
+			Entry(
+				"Empty",
+				&PodTemplate{},
+				nil,
+			),
+			Entry(
+				"Zone spread disabled",
+				&PodTemplate{
+					TopologySpreadZoneEnabled: ptr.To(false),
+				},
+				nil,
+			),
+			Entry(
+				"Zone spread enabled",
+				&PodTemplate{
+					TopologySpreadZoneEnabled: ptr.To(true),
+				},
+				[]TopologySpreadConstraint{
+					zoneConstraint,
+				},
+			),
+			Entry(
+				"Zone spread enabled with custom constraints",
+				&PodTemplate{
+					TopologySpreadZoneEnabled: ptr.To(true),
+					TopologySpreadConstraints: []TopologySpreadConstraint{
+						{
+							MaxSkew:           2,
+							TopologyKey:       "kubernetes.io/hostname",
+							WhenUnsatisfiable: corev1.ScheduleAnyway,
+						},
+					},
+				},
+				[]TopologySpreadConstraint{
+					{
+						MaxSkew:           2,
+						TopologyKey:       "kubernetes.io/hostname",
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+					},
+				},
+			),
+			Entry(
+				"Hostname spread disabled",
+				&PodTemplate{
+					TopologySpreadHostnameEnabled: ptr.To(false),
+				},
+				nil,
+			),
+			Entry(
+				"Hostname spread enabled",
+				&PodTemplate{
+					TopologySpreadHostnameEnabled: ptr.To(true),
+				},
+				[]TopologySpreadConstraint{
+					hostnameConstraint,
+				},
+			),
+			Entry(
+				"Hostname spread enabled with zone spread",
+				&PodTemplate{
+					TopologySpreadZoneEnabled:     ptr.To(true),
+					TopologySpreadHostnameEnabled: ptr.To(true),
+				},
+				[]TopologySpreadConstraint{
+					zoneConstraint,
+				},
+			),
+			Entry(
+				"Hostname spread enabled with anti-affinity",
+				&PodTemplate{
+					TopologySpreadHostnameEnabled: ptr.To(true),
+					Affinity: &AffinityConfig{
+						AntiAffinityEnabled: ptr.To(true),
+					},
+				},
+				nil,
+			),
+			Entry(
+				"Hostname spread enabled with custom anti-affinity",
+				&PodTemplate{
+					TopologySpreadHostnameEnabled: ptr.To(true),
+					Affinity: &AffinityConfig{
+						Affinity: Affinity{
+							PodAntiAffinity: &PodAntiAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: []PodAffinityTerm{
+									{
+										TopologyKey: "kubernetes.io/hostname",
+									},
+								},
+							},
+						},
+					},
+				},
+				nil,
+			),
+			Entry(
+				"Hostname spread enabled with custom constraints",
+				&PodTemplate{
+					TopologySpreadHostnameEnabled: ptr.To(true),
+					TopologySpreadConstraints: []TopologySpreadConstraint{
+						{
+							MaxSkew:           2,
+							TopologyKey:       "topology.kubernetes.io/zone",
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+						},
+					},
+				},
+				[]TopologySpreadConstraint{
+					{
+						MaxSkew:           2,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.DoNotSchedule,
+					},
+				},
+			),
+		)
+
+		It("Should not persist the zone topology spread constraint", func() {
+			podTpl := &PodTemplate{
+				TopologySpreadZoneEnabled: ptr.To(true),
+			}
+			podTpl.SetDefaults(objMeta)
+			Expect(podTpl.TopologySpreadConstraints).To(BeNil())
+
+			podTpl.TopologySpreadZoneEnabled = ptr.To(false)
+			Expect(podTpl.TopologySpreadConstraintsOrDefault(objMeta)).To(BeNil())
+		})
+
+		It("Should build a zone topology spread constraint", func() {
+			Expect(ZoneTopologySpreadConstraint(objMeta)).To(BeEquivalentTo(zoneConstraint))
+		})
+
+		It("Should not persist the hostname topology spread constraint", func() {
+			podTpl := &PodTemplate{
+				TopologySpreadHostnameEnabled: ptr.To(true),
+			}
+			podTpl.SetDefaults(objMeta)
+			Expect(podTpl.TopologySpreadConstraints).To(BeNil())
+
+			podTpl.Affinity = &AffinityConfig{
+				AntiAffinityEnabled: ptr.To(true),
+			}
+			Expect(podTpl.TopologySpreadConstraintsOrDefault(objMeta)).To(BeNil())
+		})
+
+		It("Should build a hostname topology spread constraint", func() {
+			Expect(HostnameTopologySpreadConstraint(objMeta)).To(BeEquivalentTo(hostnameConstraint))
+		})
+	})
+
 	Context("When merging multiple Metadata instances", func() {
 		DescribeTable(
 			"Should succeed",
@@ -15,25 +15,34 @@ const (
 	ConditionTypeStorageResized string = "StorageResized"
 	// ConditionTypeUpdated indicates that an update has been successfully completed.
 	ConditionTypeUpdated string = "Updated"
+	// ConditionTypePaused indicates that the reconciliation has been paused via annotation.
+	ConditionTypePaused string = "Paused"
+	// ConditionTypeInitSQLApplied indicates that the InitSQL statements have been run.
+	ConditionTypeInitSQLApplied string = "InitSQLApplied"
 
-	ConditionReasonStatefulSetNotReady string = "StatefulSetNotReady"
-	ConditionReasonStatefulSetReady    string = "StatefulSetReady"
-	ConditionReasonRestoreBackup       string = "RestoreBackup"
-	ConditionReasonSwitchPrimary       string = "SwitchPrimary"
-	ConditionReasonGaleraReady         string = "GaleraReady"
-	ConditionReasonGaleraNotReady      string = "GaleraNotReady"
-	ConditionReasonGaleraConfigured    string = "GaleraConfigured"
-	ConditionReasonGaleraInitialized   string = "GaleraInitialized"
-	ConditionReasonGaleraInitializing  string = "GaleraInitializing"
-	ConditionReasonResizingStorage     string = "ResizingStorage"
-	ConditionReasonWaitStorageResize   string = "WaitStorageResize"
-	ConditionReasonStorageResized      string = "StorageResized"
-	ConditionReasonInitializing        string = "Initializing"
-	ConditionReasonInitialized         string = "Initialized"
-	ConditionReasonPendingUpdate       string = "PendingUpdate"
-	ConditionReasonUpdating            string = "Updating"
-	ConditionReasonUpdated             string = "Updated"
-	ConditionReasonSuspended           string = "Suspended"
+	ConditionReasonStatefulSetNotReady   string = "StatefulSetNotReady"
+	ConditionReasonStatefulSetReady      string = "StatefulSetReady"
+	ConditionReasonRestoreBackup         string = "RestoreBackup"
+	ConditionReasonSwitchPrimary         string = "SwitchPrimary"
+	ConditionReasonGaleraReady           string = "GaleraReady"
+	ConditionReasonGaleraNotReady        string = "GaleraNotReady"
+	ConditionReasonGaleraConfigured      string = "GaleraConfigured"
+	ConditionReasonGaleraInitialized     string = "GaleraInitialized"
+	ConditionReasonGaleraInitializing    string = "GaleraInitializing"
+	ConditionReasonGaleraUnsafeBootstrap string = "GaleraUnsafeBootstrap"
+	ConditionReasonGaleraSplitBrain      string = "GaleraSplitBrain"
+	ConditionReasonResizingStorage       string = "ResizingStorage"
+	ConditionReasonWaitStorageResize     string = "WaitStorageResize"
+	ConditionReasonStorageResized        string = "StorageResized"
+	ConditionReasonInitializing          string = "Initializing"
+	ConditionReasonInitialized           string = "Initialized"
+	ConditionReasonPendingUpdate         string = "PendingUpdate"
+	ConditionReasonUpdating              string = "Updating"
+	ConditionReasonUpdated               string = "Updated"
+	ConditionReasonSuspended             string = "Suspended"
+	ConditionReasonPaused                string = "Paused"
+	ConditionReasonResumed               string = "Resumed"
+	ConditionReasonInitSQLApplied        string = "InitSQLApplied"
 
 	ConditionReasonMaxScaleNotReady string = "MaxScaleNotReady"
 	ConditionReasonMaxScaleReady    string = "MaxScaleReady"
@@ -64,7 +64,7 @@ func IndexConnection(ctx context.Context, mgr manager.Manager, builder *ctrlbuil
 			&ConnectionList{},
 			fieldPath,
 			ctrlbuilder.WithPredicates(
-				predicate.PredicateWithLabel(metadata.WatchLabel),
+				predicate.PredicateWithLabelSelector(metadata.WatchLabelSelector()),
 			),
 		); err != nil {
 			return fmt.Errorf("error watching '%s': %v", fieldPath, err)
@@ -30,10 +30,28 @@ const (
 	ReasonGaleraClusterBootstrapTimeout = "GaleraClusterBootstrapTimeout"
 	// ReasonGaleraPodStateFetched indicates that the Pod state has been fetched successfully.
 	ReasonGaleraPodStateFetched = "GaleraPodStateFetched"
+	// ReasonGaleraAgentUnreachable indicates that the agent refused the connection while fetching the Galera state, i.e. it is not running.
+	ReasonGaleraAgentUnreachable = "GaleraAgentUnreachable"
+	// ReasonGaleraPodUnreachable indicates that the Pod could not be reached while fetching the Galera state, i.e. it is down.
+	ReasonGaleraPodUnreachable = "GaleraPodUnreachable"
+	// ReasonGaleraAgentTimeout indicates that the agent timed out while fetching the Galera state.
+	ReasonGaleraAgentTimeout = "GaleraAgentTimeout"
+	// ReasonGaleraStateNotFound indicates that the Galera state file was not found in the Pod.
+	ReasonGaleraStateNotFound = "GaleraStateNotFound"
+	// ReasonGaleraStateError indicates an unclassified error fetching the Galera state.
+	ReasonGaleraStateError = "GaleraStateError"
 	// ReasonGaleraPodRecovered indicates that the Pod has successfully recovered the sequence.
 	ReasonGaleraPodRecovered = "GaleraPodRecovered"
 	// ReasonGaleraPodSyncTimeout indicates that the Pod has timed out reaching the Sync state.
 	ReasonGaleraPodSyncTimeout = "GaleraPodSyncTimeout"
+	// ReasonGaleraRecoveryAborted indicates that the cluster recovery has been aborted via annotation.
+	ReasonGaleraRecoveryAborted = "GaleraRecoveryAborted"
+	// ReasonGaleraPodExcluded indicates that the Pod has been excluded from the cluster recovery.
+	ReasonGaleraPodExcluded = "GaleraPodExcluded"
+	// ReasonGaleraSplitBrain indicates that multiple Pods are safe to bootstrap, which might lead to a split-brain.
+	ReasonGaleraSplitBrain = "GaleraSplitBrain"
+	// ReasonGaleraUnsafeBootstrap indicates that the safe-to-bootstrap check has been bypassed via annotation.
+	ReasonGaleraUnsafeBootstrap = "GaleraUnsafeBootstrap"
 	// ReasonGaleraPVCNotBound indicates that a Galera PVC is not in Bound phase, therefore the init process cannot be started.
 	ReasonGaleraPVCNotBound = "GaleraPVCNotBound"
 
@@ -222,6 +222,65 @@ func (p ProbeHandler) ToKubernetesType() corev1.ProbeHandler {
 	return probe
 }
 
+// Refer to the Kubernetes docs: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.31/#sleepaction-v1-core.
+type SleepAction struct {
+	Seconds int64 `json:"seconds"`
+}
+
+func (s SleepAction) ToKubernetesType() corev1.SleepAction {
+	return corev1.SleepAction{
+		Seconds: s.Seconds,
+	}
+}
+
+// Refer to the Kubernetes docs: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.31/#lifecyclehandler-v1-core.
+type LifecycleHandler struct {
+	// +optional
+	Exec *ExecAction `json:"exec,omitempty"`
+	// +optional
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+	// +optional
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"`
+	// +optional
+	Sleep *SleepAction `json:"sleep,omitempty"`
+}
+
+func (h LifecycleHandler) ToKubernetesType() corev1.LifecycleHandler {
+	var handler corev1.LifecycleHandler
+	if h.Exec != nil {
+		handler.Exec = ptr.To(h.Exec.ToKubernetesType())
+	}
+	if h.HTTPGet != nil {
+		handler.HTTPGet = ptr.To(h.HTTPGet.ToKubernetesType())
+	}
+	if h.TCPSocket != nil {
+		handler.TCPSocket = ptr.To(h.TCPSocket.ToKubernetesType())
+	}
+	if h.Sleep != nil {
+		handler.Sleep = ptr.To(h.Sleep.ToKubernetesType())
+	}
+	return handler
+}
+
+// Refer to the Kubernetes docs: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.31/#lifecycle-v1-core.
+type Lifecycle struct {
+	// +optional
+	PostStart *LifecycleHandler `json:"postStart,omitempty"`
+	// +optional
+	PreStop *LifecycleHandler `json:"preStop,omitempty"`
+}
+
+func (l Lifecycle) ToKubernetesType() corev1.Lifecycle {
+	var lifecycle corev1.Lifecycle
+	if l.PostStart != nil {
+		lifecycle.PostStart = ptr.To(l.PostStart.ToKubernetesType())
+	}
+	if l.PreStop != nil {
+		lifecycle.PreStop = ptr.To(l.PreStop.ToKubernetesType())
+	}
+	return lifecycle
+}
+
 // Refer to the Kubernetes docs: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.31/#probe-v1-core.
 type Probe struct {
 	ProbeHandler `json:",inline"`
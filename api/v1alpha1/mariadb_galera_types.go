@@ -10,6 +10,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/docker"
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/galera/recovery"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -312,6 +313,23 @@ type GaleraRecovery struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	PodSyncTimeout *metav1.Duration `json:"podSyncTimeout,omitempty"`
+	// PodSyncInitialDelay is the time to wait before checking whether a restarted Pod has joined the cluster during the cluster recovery.
+	// Subsequent checks are performed with an exponential backoff, bounded by 'PodSyncTimeout'.
+	// You may increase it to avoid overwhelming Pods that are applying a large SST right after being restarted.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PodSyncInitialDelay *metav1.Duration `json:"podSyncInitialDelay,omitempty"`
+	// AgentTimeout is the time limit for the requests performed to the agent during the cluster recovery.
+	// It defaults to 5s, you may increase it to give the agent enough time to operate with large datasets.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AgentTimeout *metav1.Duration `json:"agentTimeout,omitempty"`
+	// Concurrency is the maximum number of Pods that are operated in parallel when fetching and recovering the Galera state.
+	// It defaults to the number of Pods, you may decrease it to avoid overwhelming the cluster or the Kubernetes API server.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Concurrency *int32 `json:"concurrency,omitempty"`
 	// ForceClusterBootstrapInPod allows you to manually initiate the bootstrap process in a specific Pod.
 	// IMPORTANT: Use this option only in exceptional circumstances. Not selecting the Pod with the highest sequence number may result in data loss.
 	// IMPORTANT: Ensure you unset this field after completing the bootstrap to allow the operator to choose the appropriate Pod to bootstrap from in an event of cluster recovery.
@@ -341,6 +359,9 @@ func (g *GaleraRecovery) Validate(mdb *MariaDB) error {
 			}
 		}
 	}
+	if g.Concurrency != nil && *g.Concurrency < 1 {
+		return fmt.Errorf("'spec.galera.recovery.concurrency' must be greater than 0: %d", *g.Concurrency)
+	}
 	if g.ForceClusterBootstrapInPod != nil {
 		if err := statefulset.ValidPodName(mdb.ObjectMeta, int(mdb.Spec.Replicas), *g.ForceClusterBootstrapInPod); err != nil {
 			return fmt.Errorf("'spec.galera.recovery.forceClusterBootstrapInPod' invalid: %v", err)
@@ -375,6 +396,9 @@ func (g *GaleraRecovery) SetDefaults(mdb *MariaDB) {
 	if g.PodSyncTimeout == nil {
 		g.PodSyncTimeout = ptr.To(metav1.Duration{Duration: 5 * time.Minute})
 	}
+	if g.AgentTimeout == nil {
+		g.AgentTimeout = ptr.To(metav1.Duration{Duration: 5 * time.Second})
+	}
 }
 
 // HasMinClusterSize returns whether the current cluster has the minimum number of replicas. If not, a cluster recovery will be performed.
@@ -543,6 +567,10 @@ type GaleraSpec struct {
 type GaleraBootstrapStatus struct {
 	Time *metav1.Time `json:"time,omitempty"`
 	Pod  *string      `json:"pod,omitempty"`
+	// Seqno is the sequence number of the Pod chosen to bootstrap the cluster.
+	Seqno *int `json:"seqno,omitempty"`
+	// UUID is the cluster UUID of the Pod chosen to bootstrap the cluster.
+	UUID *string `json:"uuid,omitempty"`
 }
 
 // GaleraRecoveryStatus is the current state of the Galera recovery process.
@@ -584,3 +612,13 @@ func (m *MariaDB) IsGaleraInitialized() bool {
 func (m *MariaDB) IsGaleraInitializing() bool {
 	return meta.IsStatusConditionFalse(m.Status.Conditions, ConditionTypeGaleraInitialized)
 }
+
+// IsGaleraRecoveryAborted indicates that the Galera cluster recovery has been aborted via annotation.
+func (m *MariaDB) IsGaleraRecoveryAborted() bool {
+	return m.Annotations[metadata.GaleraAbortRecoveryAnnotation] == "true"
+}
+
+// IsGaleraUnsafeBootstrapForced indicates that the safe-to-bootstrap check has been bypassed via annotation.
+func (m *MariaDB) IsGaleraUnsafeBootstrapForced() bool {
+	return m.Annotations[metadata.GaleraForceUnsafeBootstrapAnnotation] == "true"
+}
@@ -88,6 +88,7 @@ var _ = Describe("MariaDB Galera types", func() {
 							ClusterDownscaleTimeout: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
 							PodRecoveryTimeout:      ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
 							PodSyncTimeout:          ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+							AgentTimeout:            ptr.To(metav1.Duration{Duration: 5 * time.Second}),
 						},
 					},
 				},
@@ -173,6 +174,7 @@ var _ = Describe("MariaDB Galera types", func() {
 							ClusterDownscaleTimeout: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
 							PodRecoveryTimeout:      ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
 							PodSyncTimeout:          ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+							AgentTimeout:            ptr.To(metav1.Duration{Duration: 5 * time.Second}),
 						},
 					},
 				},
@@ -574,6 +576,40 @@ var _ = Describe("MariaDB Galera types", func() {
 				},
 				false,
 			),
+			Entry(
+				"Valid concurrency",
+				&MariaDB{
+					Spec: MariaDBSpec{
+						Replicas: 3,
+						Galera: &Galera{
+							GaleraSpec: GaleraSpec{
+								Recovery: &GaleraRecovery{
+									Enabled:     true,
+									Concurrency: ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid concurrency",
+				&MariaDB{
+					Spec: MariaDBSpec{
+						Replicas: 3,
+						Galera: &Galera{
+							GaleraSpec: GaleraSpec{
+								Recovery: &GaleraRecovery{
+									Enabled:     true,
+									Concurrency: ptr.To(int32(0)),
+								},
+							},
+						},
+					},
+				},
+				true,
+			),
 			Entry(
 				"Integer negative",
 				&MariaDB{
@@ -643,5 +679,155 @@ var _ = Describe("MariaDB Galera types", func() {
 				false,
 			),
 		)
+
+		DescribeTable("Should default hostname topology spread",
+			func(mdb *MariaDB, wantEnabled *bool, wantConstraints []TopologySpreadConstraint) {
+				mdb.Default()
+				Expect(mdb.SetDefaults(env)).To(Succeed())
+				Expect(mdb.Spec.TopologySpreadHostnameEnabled).To(Equal(wantEnabled))
+				Expect(mdb.Spec.TopologySpreadConstraintsOrDefault(mdb.ObjectMeta)).To(BeEquivalentTo(wantConstraints))
+			},
+			Entry(
+				"Galera disabled",
+				&MariaDB{
+					ObjectMeta: mdbObjMeta,
+				},
+				nil,
+				nil,
+			),
+			Entry(
+				"Existing Galera",
+				&MariaDB{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              mdbObjMeta.Name,
+						Namespace:         mdbObjMeta.Namespace,
+						CreationTimestamp: metav1.Now(),
+					},
+					Spec: MariaDBSpec{
+						Galera: &Galera{
+							Enabled: true,
+						},
+					},
+				},
+				nil,
+				nil,
+			),
+			Entry(
+				"Galera enabled",
+				&MariaDB{
+					ObjectMeta: mdbObjMeta,
+					Spec: MariaDBSpec{
+						Galera: &Galera{
+							Enabled: true,
+						},
+					},
+				},
+				ptr.To(true),
+				[]TopologySpreadConstraint{
+					HostnameTopologySpreadConstraint(mdbObjMeta),
+				},
+			),
+			Entry(
+				"Galera enabled with hostname spread disabled",
+				&MariaDB{
+					ObjectMeta: mdbObjMeta,
+					Spec: MariaDBSpec{
+						Galera: &Galera{
+							Enabled: true,
+						},
+						PodTemplate: PodTemplate{
+							TopologySpreadHostnameEnabled: ptr.To(false),
+						},
+					},
+				},
+				ptr.To(false),
+				nil,
+			),
+			Entry(
+				"Galera enabled with anti-affinity",
+				&MariaDB{
+					ObjectMeta: mdbObjMeta,
+					Spec: MariaDBSpec{
+						Galera: &Galera{
+							Enabled: true,
+						},
+						PodTemplate: PodTemplate{
+							Affinity: &AffinityConfig{
+								AntiAffinityEnabled: ptr.To(true),
+							},
+						},
+					},
+				},
+				ptr.To(true),
+				nil,
+			),
+			Entry(
+				"Galera enabled with custom constraints",
+				&MariaDB{
+					ObjectMeta: mdbObjMeta,
+					Spec: MariaDBSpec{
+						Galera: &Galera{
+							Enabled: true,
+						},
+						PodTemplate: PodTemplate{
+							TopologySpreadConstraints: []TopologySpreadConstraint{
+								{
+									MaxSkew:           1,
+									TopologyKey:       "topology.kubernetes.io/zone",
+									WhenUnsatisfiable: corev1.DoNotSchedule,
+								},
+							},
+						},
+					},
+				},
+				ptr.To(true),
+				[]TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.DoNotSchedule,
+					},
+				},
+			),
+		)
+
+		DescribeTable("Is Galera recovery aborted",
+			func(mdb *MariaDB, wantAborted bool) {
+				Expect(mdb.IsGaleraRecoveryAborted()).To(Equal(wantAborted))
+			},
+			Entry(
+				"No annotations",
+				&MariaDB{
+					ObjectMeta: mdbObjMeta,
+				},
+				false,
+			),
+			Entry(
+				"Abort annotation",
+				&MariaDB{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mariadb-galera",
+						Namespace: testNamespace,
+						Annotations: map[string]string{
+							"k8s.mariadb.com/galera-abort-recovery": "true",
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Abort annotation disabled",
+				&MariaDB{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mariadb-galera",
+						Namespace: testNamespace,
+						Annotations: map[string]string{
+							"k8s.mariadb.com/galera-abort-recovery": "false",
+						},
+					},
+				},
+				false,
+			),
+		)
 	})
 })
@@ -110,7 +110,7 @@ func IndexMariaDB(ctx context.Context, mgr manager.Manager, builder *ctrlbuilder
 		&MariaDBList{},
 		mariadbMyCnfConfigMapFieldPath,
 		ctrlbuilder.WithPredicates(
-			predicate.PredicateWithLabel(metadata.WatchLabel),
+			predicate.PredicateWithLabelSelector(metadata.WatchLabelSelector()),
 		),
 	); err != nil {
 		return fmt.Errorf("error watching '%s': %v", mariadbMyCnfConfigMapFieldPath, err)
@@ -131,7 +131,7 @@ func IndexMariaDB(ctx context.Context, mgr manager.Manager, builder *ctrlbuilder
 			&MariaDBList{},
 			fieldPath,
 			ctrlbuilder.WithPredicates(
-				predicate.PredicateWithLabel(metadata.WatchLabel),
+				predicate.PredicateWithLabelSelector(metadata.WatchLabelSelector()),
 			),
 		); err != nil {
 			return fmt.Errorf("error watching '%s': %v", fieldPath, err)
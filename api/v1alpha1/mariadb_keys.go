@@ -181,14 +181,21 @@ func (m *MariaDB) PrimaryServiceKey() types.NamespacedName {
 	}
 }
 
-// PrimaryConnectioneKey defines the key for the primary Connection
-func (m *MariaDB) PrimaryConnectioneKey() types.NamespacedName {
+// PrimaryConnectionKey defines the key for the primary Connection
+func (m *MariaDB) PrimaryConnectionKey() types.NamespacedName {
 	return types.NamespacedName{
 		Name:      fmt.Sprintf("%s-primary", m.Name),
 		Namespace: m.Namespace,
 	}
 }
 
+// PrimaryConnectioneKey defines the key for the primary Connection
+//
+// Deprecated: use PrimaryConnectionKey instead. It will be removed in a future release.
+func (m *MariaDB) PrimaryConnectioneKey() types.NamespacedName {
+	return m.PrimaryConnectionKey()
+}
+
 // SecondaryServiceKey defines the key for the secondary Service
 func (m *MariaDB) SecondaryServiceKey() types.NamespacedName {
 	return types.NamespacedName{
@@ -197,14 +204,21 @@ func (m *MariaDB) SecondaryServiceKey() types.NamespacedName {
 	}
 }
 
-// SecondaryConnectioneKey defines the key for the secondary Connection
-func (m *MariaDB) SecondaryConnectioneKey() types.NamespacedName {
+// SecondaryConnectionKey defines the key for the secondary Connection
+func (m *MariaDB) SecondaryConnectionKey() types.NamespacedName {
 	return types.NamespacedName{
 		Name:      fmt.Sprintf("%s-secondary", m.Name),
 		Namespace: m.Namespace,
 	}
 }
 
+// SecondaryConnectioneKey defines the key for the secondary Connection
+//
+// Deprecated: use SecondaryConnectionKey instead. It will be removed in a future release.
+func (m *MariaDB) SecondaryConnectioneKey() types.NamespacedName {
+	return m.SecondaryConnectionKey()
+}
+
 // MetricsKey defines the key for the metrics related resources
 func (m *MariaDB) MetricsKey() types.NamespacedName {
 	return types.NamespacedName{
@@ -3,9 +3,12 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"path"
+	"slices"
 
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -86,14 +89,68 @@ type Storage struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch","urn:alm:descriptor:com.tectonic.ui:advanced"}
 	WaitForVolumeResize *bool `json:"waitForVolumeResize,omitempty"`
+	// PersistentVolumeClaimRetentionPolicy describes the lifecycle of the PVCs created by the StatefulSet.
+	// It allows Kubernetes to delete the PVCs when the MariaDB is deleted or scaled down. By default, the PVCs are retained.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
+	PersistentVolumeClaimRetentionPolicy *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
 	// VolumeClaimTemplate provides a template to define the PVCs.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
 	VolumeClaimTemplate *VolumeClaimTemplate `json:"volumeClaimTemplate,omitempty"`
+	// AdditionalVolumeClaimTemplates are extra PVCs provisioned for every Pod and mounted in the MariaDB container,
+	// for instance, to keep the binary logs in a separate volume.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
+	AdditionalVolumeClaimTemplates []AdditionalVolumeClaimTemplate `json:"additionalVolumeClaimTemplates,omitempty" webhook:"inmutable"`
+}
+
+// AdditionalVolumeClaimTemplate defines an extra PVC to be mounted in the MariaDB container.
+type AdditionalVolumeClaimTemplate struct {
+	// Name of the PVC template and the volume. It must not collide with the volumes managed by the operator.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// MountPath is the path within the MariaDB container at which the volume should be mounted.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	MountPath string `json:"mountPath"`
+	// VolumeClaimTemplate provides a template to define the PVC.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	VolumeClaimTemplate `json:",inline"`
+}
+
+// reservedVolumeNames are the names of the volumes managed by the operator.
+var reservedVolumeNames = []string{"storage", "galera"}
+
+func (s *Storage) validateAdditionalVolumeClaimTemplates(mdb *MariaDB) error {
+	names := make(map[string]struct{})
+	for _, vol := range mdb.Spec.Volumes {
+		names[vol.Name] = struct{}{}
+	}
+	for _, vctpl := range s.AdditionalVolumeClaimTemplates {
+		if vctpl.Name == "" || vctpl.MountPath == "" {
+			return errors.New("Name and mountPath must be provided in additionalVolumeClaimTemplates")
+		}
+		if slices.Contains(reservedVolumeNames, vctpl.Name) {
+			return fmt.Errorf("Volume name '%s' in additionalVolumeClaimTemplates is reserved", vctpl.Name)
+		}
+		if _, ok := names[vctpl.Name]; ok {
+			return fmt.Errorf("Volume name '%s' in additionalVolumeClaimTemplates is duplicated", vctpl.Name)
+		}
+		if path.Clean(vctpl.MountPath) == "/var/lib/mysql" {
+			return fmt.Errorf("Mount path '%s' in additionalVolumeClaimTemplates is reserved for the data volume", vctpl.MountPath)
+		}
+		names[vctpl.Name] = struct{}{}
+	}
+	return nil
 }
 
 // Storate determines whether a Storage object is valid.
 func (s *Storage) Validate(mdb *MariaDB) error {
+	if err := s.validateAdditionalVolumeClaimTemplates(mdb); err != nil {
+		return err
+	}
 	if s.Ephemeral != nil {
 		if *s.Ephemeral && mdb.IsHAEnabled() {
 			return errors.New("Ephemeral storage is only compatible with non HA MariaDBs")
@@ -270,6 +327,27 @@ type BootstrapFrom struct {
 	RestoreJob *Job `json:"restoreJob,omitempty"`
 }
 
+// InitSQL defines the SQL statements to be run once after MariaDB is first bootstrapped.
+// Exactly one of ConfigMapKeyRef or SecretKeyRef must be set.
+type InitSQL struct {
+	// ConfigMapKeyRef is a reference to a ConfigMap key containing the SQL statements.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef is a reference to a Secret key containing the SQL statements, meant for statements containing sensitive data.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// Validate determines whether an InitSQL is valid.
+func (i *InitSQL) Validate() error {
+	if (i.ConfigMapKeyRef == nil) == (i.SecretKeyRef == nil) {
+		return errors.New("exactly one of 'configMapKeyRef' or 'secretKeyRef' must be set")
+	}
+	return nil
+}
+
 // UpdateType defines the type of update for a MariaDB resource.
 type UpdateType string
 
@@ -452,6 +530,13 @@ type MariaDBSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	BootstrapFrom *BootstrapFrom `json:"bootstrapFrom,omitempty"`
+	// InitSQL defines SQL statements to be run once against the primary after MariaDB is first bootstrapped,
+	// for instance to create schemas or seed data.
+	// They are run after the BootstrapFrom restoration, if any, within a single transaction, and retried until they succeed.
+	// As DDL statements cause an implicit commit and cannot be rolled back, the statements should be idempotent.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InitSQL *InitSQL `json:"initSql,omitempty" webhook:"inmutable"`
 	// Storage defines the storage options to be used for provisioning the PVCs mounted by MariaDB.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -507,6 +592,19 @@ type MariaDBSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
+	// PodManagementPolicy defines how the StatefulSet creates and deletes Pods. It defaults to `Parallel`.
+	// This field is inmutable, as the StatefulSet does not support changing it once created.
+	// +optional
+	// +kubebuilder:default=Parallel
+	// +kubebuilder:validation:Enum=OrderedReady;Parallel
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
+	PodManagementPolicy appsv1.PodManagementPolicyType `json:"podManagementPolicy,omitempty" webhook:"inmutable"`
+	// MinReadySeconds is the minimum number of seconds for which a newly created Pod should be ready
+	// without any of its containers crashing for it to be considered available.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number","urn:alm:descriptor:com.tectonic.ui:advanced"}
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
 	// Service defines a template to configure the general Service object.
 	// The network traffic of this Service will be routed to all Pods.
 	// +optional
@@ -790,6 +888,11 @@ func (m *MariaDB) HasRestoredBackup() bool {
 	return meta.IsStatusConditionTrue(m.Status.Conditions, ConditionTypeBackupRestored)
 }
 
+// HasAppliedInitSQL indicates whether the MariaDB instance has run the InitSQL statements
+func (m *MariaDB) HasAppliedInitSQL() bool {
+	return meta.IsStatusConditionTrue(m.Status.Conditions, ConditionTypeInitSQLApplied)
+}
+
 // IsResizingStorage indicates whether the MariaDB instance is resizing storage
 func (m *MariaDB) IsResizingStorage() bool {
 	return meta.IsStatusConditionFalse(m.Status.Conditions, ConditionTypeStorageResized)
@@ -827,6 +930,16 @@ func (m *MariaDB) IsSuspended() bool {
 	return m.Spec.Suspend
 }
 
+// IsPaused whether the reconciliation of a MariaDB has been paused via annotation.
+func (m *MariaDB) IsPaused() bool {
+	return m.Annotations[metadata.PausedAnnotation] == "true"
+}
+
+// HasPausedCondition whether the MariaDB has been marked as paused.
+func (m *MariaDB) HasPausedCondition() bool {
+	return meta.IsStatusConditionTrue(m.Status.Conditions, ConditionTypePaused)
+}
+
 // ServerDNSNames are the Service DNS names used by server TLS certificates.
 func (m *MariaDB) TLSServerDNSNames() []string {
 	var names []string
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 )
 
@@ -1454,4 +1455,36 @@ var _ = Describe("MariaDB types", func() {
 			),
 		)
 	})
+
+	Context("When getting Connection keys", func() {
+		mdb := &MariaDB{
+			ObjectMeta: objMeta,
+		}
+		DescribeTable(
+			"Should build the key",
+			func(key types.NamespacedName, wantKey types.NamespacedName) {
+				Expect(key).To(Equal(wantKey))
+			},
+			Entry(
+				"Primary Connection",
+				mdb.PrimaryConnectionKey(),
+				types.NamespacedName{Name: "mariadb-obj-primary", Namespace: "mariadb-obj"},
+			),
+			Entry(
+				"Secondary Connection",
+				mdb.SecondaryConnectionKey(),
+				types.NamespacedName{Name: "mariadb-obj-secondary", Namespace: "mariadb-obj"},
+			),
+			Entry(
+				"Deprecated primary Connection",
+				mdb.PrimaryConnectioneKey(), //nolint:staticcheck
+				mdb.PrimaryConnectionKey(),
+			),
+			Entry(
+				"Deprecated secondary Connection",
+				mdb.SecondaryConnectioneKey(), //nolint:staticcheck
+				mdb.SecondaryConnectionKey(),
+			),
+		)
+	})
 })
@@ -28,6 +28,12 @@ var _ webhook.Defaulter = &MariaDB{}
 
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *MariaDB) Default() {
+	// CreationTimestamp is only set after the mutating admission on create. Defaulting on update would change the Pod template
+	// of existing Galera clusters and trigger a rolling update.
+	if r.CreationTimestamp.IsZero() && r.IsGaleraEnabled() && r.Spec.TopologySpreadHostnameEnabled == nil {
+		mariadbLogger.V(1).Info("Defaulting spec.topologySpreadHostnameEnabled", "mariadb", r.Name)
+		r.Spec.TopologySpreadHostnameEnabled = ptr.To(true)
+	}
 	if r.Spec.Replication != nil && r.Spec.Replication.Enabled {
 		mariadbLogger.V(1).Info("Defaulting spec.replication", "mariadb", r.Name)
 		r.Spec.Replication.FillWithDefaults()
@@ -47,6 +53,7 @@ func (r *MariaDB) ValidateCreate() (admission.Warnings, error) {
 		r.validateGalera,
 		r.validateReplication,
 		r.validateBootstrapFrom,
+		r.validateInitSQL,
 		r.validatePodDisruptionBudget,
 		r.validateStorage,
 		r.validateRootPassword,
@@ -73,6 +80,7 @@ func (r *MariaDB) ValidateUpdate(old runtime.Object) (admission.Warnings, error)
 		r.validateGalera,
 		r.validateReplication,
 		r.validateBootstrapFrom,
+		r.validateInitSQL,
 		r.validatePodDisruptionBudget,
 		r.validateStorage,
 		r.validateRootPassword,
@@ -247,6 +255,20 @@ func (r *MariaDB) validateBootstrapFrom() error {
 	return nil
 }
 
+func (r *MariaDB) validateInitSQL() error {
+	if r.Spec.InitSQL == nil {
+		return nil
+	}
+	if err := r.Spec.InitSQL.Validate(); err != nil {
+		return field.Invalid(
+			field.NewPath("spec").Child("initSql"),
+			r.Spec.InitSQL,
+			err.Error(),
+		)
+	}
+	return nil
+}
+
 func (r *MariaDB) validatePodDisruptionBudget() error {
 	if r.Spec.PodDisruptionBudget == nil {
 		return nil
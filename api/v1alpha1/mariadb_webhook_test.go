@@ -5,6 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -80,6 +81,85 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Valid InitSQL with ConfigMap",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						InitSQL: &InitSQL{
+							ConfigMapKeyRef: &ConfigMapKeySelector{
+								LocalObjectReference: LocalObjectReference{
+									Name: "init-sql",
+								},
+								Key: "init.sql",
+							},
+						},
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Valid InitSQL with Secret",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						InitSQL: &InitSQL{
+							SecretKeyRef: &SecretKeySelector{
+								LocalObjectReference: LocalObjectReference{
+									Name: "init-sql",
+								},
+								Key: "init.sql",
+							},
+						},
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid InitSQL with no source",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						InitSQL: &InitSQL{},
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid InitSQL with multiple sources",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						InitSQL: &InitSQL{
+							ConfigMapKeyRef: &ConfigMapKeySelector{
+								LocalObjectReference: LocalObjectReference{
+									Name: "init-sql",
+								},
+								Key: "init.sql",
+							},
+							SecretKeyRef: &SecretKeySelector{
+								LocalObjectReference: LocalObjectReference{
+									Name: "init-sql",
+								},
+								Key: "init.sql",
+							},
+						},
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+						},
+					},
+				},
+				true,
+			),
 			Entry(
 				"Valid Galera",
 				&MariaDB{
@@ -462,6 +542,96 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Valid additional volume claim template",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+							AdditionalVolumeClaimTemplates: []AdditionalVolumeClaimTemplate{
+								{
+									Name:      "binlogs",
+									MountPath: "/var/lib/mysql-binlogs",
+									VolumeClaimTemplate: VolumeClaimTemplate{
+										PersistentVolumeClaimSpec: PersistentVolumeClaimSpec{
+											Resources: corev1.VolumeResourceRequirements{
+												Requests: corev1.ResourceList{
+													corev1.ResourceStorage: resource.MustParse("100Mi"),
+												},
+											},
+											AccessModes: []corev1.PersistentVolumeAccessMode{
+												corev1.ReadWriteOnce,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Additional volume claim template with reserved name",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+							AdditionalVolumeClaimTemplates: []AdditionalVolumeClaimTemplate{
+								{
+									Name:      "storage",
+									MountPath: "/var/lib/mysql-binlogs",
+									VolumeClaimTemplate: VolumeClaimTemplate{
+										PersistentVolumeClaimSpec: PersistentVolumeClaimSpec{
+											Resources: corev1.VolumeResourceRequirements{
+												Requests: corev1.ResourceList{
+													corev1.ResourceStorage: resource.MustParse("100Mi"),
+												},
+											},
+											AccessModes: []corev1.PersistentVolumeAccessMode{
+												corev1.ReadWriteOnce,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Additional volume claim template with reserved mount path",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						Storage: Storage{
+							Size: ptr.To(resource.MustParse("100Mi")),
+							AdditionalVolumeClaimTemplates: []AdditionalVolumeClaimTemplate{
+								{
+									Name:      "binlogs",
+									MountPath: "/var/lib/mysql/",
+									VolumeClaimTemplate: VolumeClaimTemplate{
+										PersistentVolumeClaimSpec: PersistentVolumeClaimSpec{
+											Resources: corev1.VolumeResourceRequirements{
+												Requests: corev1.ResourceList{
+													corev1.ResourceStorage: resource.MustParse("100Mi"),
+												},
+											},
+											AccessModes: []corev1.PersistentVolumeAccessMode{
+												corev1.ReadWriteOnce,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				true,
+			),
 			Entry(
 				"Invalid rootPasswordSecretKeyRef and rootEmptyPassword",
 				&MariaDB{
@@ -755,6 +925,20 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Updating InitSQL",
+				func(mdb *MariaDB) {
+					mdb.Spec.InitSQL = &InitSQL{
+						ConfigMapKeyRef: &ConfigMapKeySelector{
+							LocalObjectReference: LocalObjectReference{
+								Name: "init-sql",
+							},
+							Key: "init.sql",
+						},
+					}
+				},
+				true,
+			),
 			Entry(
 				"Updating PasswordSecretKeyRef",
 				func(mdb *MariaDB) {
@@ -769,6 +953,20 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				false,
 			),
+			Entry(
+				"Updating PodManagementPolicy",
+				func(mdb *MariaDB) {
+					mdb.Spec.PodManagementPolicy = appsv1.OrderedReadyPodManagement
+				},
+				true,
+			),
+			Entry(
+				"Updating MinReadySeconds",
+				func(mdb *MariaDB) {
+					mdb.Spec.MinReadySeconds = 30
+				},
+				false,
+			),
 			Entry(
 				"Updating Port",
 				func(mdb *MariaDB) {
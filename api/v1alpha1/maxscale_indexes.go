@@ -131,7 +131,7 @@ func IndexMaxScale(ctx context.Context, mgr manager.Manager, builder *ctrlbuilde
 			&MaxScaleList{},
 			fieldPath,
 			ctrlbuilder.WithPredicates(
-				predicate.PredicateWithLabel(metadata.WatchLabel),
+				predicate.PredicateWithLabelSelector(metadata.WatchLabelSelector()),
 			),
 		); err != nil {
 			return fmt.Errorf("error watching '%s': %v", fieldPath, err)
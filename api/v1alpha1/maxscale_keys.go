@@ -8,6 +8,14 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// ServiceKey defines the key for the Service
+func (m *MaxScale) ServiceKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      m.Name,
+		Namespace: m.Namespace,
+	}
+}
+
 // InternalServiceKey defines the key for the internal headless Service
 func (m *MaxScale) InternalServiceKey() types.NamespacedName {
 	return types.NamespacedName{
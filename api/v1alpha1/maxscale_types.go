@@ -997,7 +997,7 @@ func (m *MaxScale) IsReplicationSSLEnabled() bool {
 
 // APIUrl returns the URL of the admin API pointing to the Kubernetes Service.
 func (m *MaxScale) APIUrl() string {
-	fqdn := statefulset.ServiceFQDNWithService(m.ObjectMeta, m.Name)
+	fqdn := statefulset.ServiceFQDNWithService(m.ObjectMeta, m.ServiceKey().Name)
 	return m.apiUrlWithAddress(fqdn)
 }
 
@@ -1073,7 +1073,7 @@ func (m *MaxScale) DefaultPort() (*int32, error) {
 // TLSAdminDNSNames are the Service DNS names used by admin TLS certificates.
 func (m *MaxScale) TLSAdminDNSNames() []string {
 	var names []string
-	names = append(names, statefulset.ServiceNameVariants(m.ObjectMeta, m.Name)...)
+	names = append(names, statefulset.ServiceNameVariants(m.ObjectMeta, m.ServiceKey().Name)...)
 	names = append(names, statefulset.ServiceNameVariants(m.ObjectMeta, m.GuiServiceKey().Name)...)
 	names = append(names, statefulset.HeadlessServiceNameVariants(m.ObjectMeta, "*", m.InternalServiceKey().Name)...)
 	return names
@@ -1082,7 +1082,7 @@ func (m *MaxScale) TLSAdminDNSNames() []string {
 // TLSListenerDNSNames are the Service DNS names used by listener TLS certificates.
 func (m *MaxScale) TLSListenerDNSNames() []string {
 	var names []string
-	names = append(names, statefulset.ServiceNameVariants(m.ObjectMeta, m.Name)...)
+	names = append(names, statefulset.ServiceNameVariants(m.ObjectMeta, m.ServiceKey().Name)...)
 	names = append(names, statefulset.HeadlessServiceNameVariants(m.ObjectMeta, "*", m.InternalServiceKey().Name)...)
 	return names
 }
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 )
 
@@ -701,4 +702,40 @@ var _ = Describe("MaxScale types", func() {
 			Expect(tls.ServerCertSecretRef).To(BeNil())
 		})
 	})
+
+	Context("When getting Service keys", func() {
+		mxs := &MaxScale{
+			ObjectMeta: objMeta,
+			Spec: MaxScaleSpec{
+				Admin: MaxScaleAdmin{
+					Port: 8989,
+				},
+			},
+		}
+		DescribeTable(
+			"Should build the key",
+			func(key types.NamespacedName, wantKey types.NamespacedName) {
+				Expect(key).To(Equal(wantKey))
+			},
+			Entry(
+				"Service",
+				mxs.ServiceKey(),
+				types.NamespacedName{Name: "maxscale-obj", Namespace: "maxscale-obj"},
+			),
+			Entry(
+				"GUI Service",
+				mxs.GuiServiceKey(),
+				types.NamespacedName{Name: "maxscale-obj-gui", Namespace: "maxscale-obj"},
+			),
+			Entry(
+				"Internal Service",
+				mxs.InternalServiceKey(),
+				types.NamespacedName{Name: "maxscale-obj-internal", Namespace: "maxscale-obj"},
+			),
+		)
+
+		It("Should use the Service for the API URL", func() {
+			Expect(mxs.APIUrl()).To(Equal("http://maxscale-obj.maxscale-obj.svc.cluster.local:8989"))
+		})
+	})
 })
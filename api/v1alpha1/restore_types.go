@@ -1,6 +1,11 @@
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +31,30 @@ type RestoreSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Database string `json:"database,omitempty"`
+	// TargetDatabase defines the logical database where the Database will be restored into, allowing to restore it under a different name.
+	// It requires Database to be set. Only names composed by alphanumeric characters and '_' are supported.
+	// References to the Database within view, trigger or routine definitions are not rewritten.
+	// IMPORTANT: The target database must previously exist.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TargetDatabase string `json:"targetDatabase,omitempty"`
+	// VerifyBackup runs an additional step before restoring to verify that the backup file was completely written by mariadb-dump,
+	// failing the Job if it is truncated. It requires the trailing comments of the backup, which are omitted by '--skip-comments'.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	VerifyBackup bool `json:"verifyBackup,omitempty"`
+	// IgnoreDatabases defines the logical databases available in the backup that will not be restored.
+	// Only names composed by alphanumeric characters and '_' are supported.
+	// The backup must include the comments rendered by mariadb-dump, it cannot be taken with the '--skip-comments' or '--compact' options.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
+	IgnoreDatabases []string `json:"ignoreDatabases,omitempty"`
+	// IgnoreTables defines the tables available in the backup that will not be restored, in 'database.table' format.
+	// Only names composed by alphanumeric characters and '_' are supported.
+	// The backup must include the comments rendered by mariadb-dump, it cannot be taken with the '--skip-comments' or '--compact' options.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced"}
+	IgnoreTables []string `json:"ignoreTables,omitempty"`
 	// LogLevel to be used n the Backup Job. It defaults to 'info'.
 	// +optional
 	// +kubebuilder:default=info
@@ -81,10 +110,48 @@ type Restore struct {
 	Status RestoreStatus `json:"status,omitempty"`
 }
 
+// restoreIdentifierRegex matches unquoted identifiers that can be safely rewritten or filtered in the backup.
+var restoreIdentifierRegex = regexp.MustCompile(`^[0-9a-zA-Z_]+$`)
+
 func (r *Restore) IsComplete() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, ConditionTypeComplete)
 }
 
+func (r *Restore) validateTargetDatabase() error {
+	if r.Spec.TargetDatabase == "" {
+		return nil
+	}
+	if r.Spec.Database == "" {
+		return errors.New("'spec.targetDatabase' may only be specified when 'spec.database' is set")
+	}
+	for _, db := range []string{r.Spec.Database, r.Spec.TargetDatabase} {
+		if !restoreIdentifierRegex.MatchString(db) {
+			return fmt.Errorf("database '%s' is not supported when 'spec.targetDatabase' is set, "+
+				"only alphanumeric characters and '_' are allowed", db)
+		}
+	}
+	return nil
+}
+
+func (r *Restore) validateIgnored() error {
+	for _, db := range r.Spec.IgnoreDatabases {
+		if !restoreIdentifierRegex.MatchString(db) {
+			return fmt.Errorf("invalid database '%s' in 'spec.ignoreDatabases', only alphanumeric characters and '_' are allowed", db)
+		}
+		if db == r.Spec.Database {
+			return fmt.Errorf("database '%s' cannot be both restored and ignored", db)
+		}
+	}
+	for _, table := range r.Spec.IgnoreTables {
+		db, name, ok := strings.Cut(table, ".")
+		if !ok || !restoreIdentifierRegex.MatchString(db) || !restoreIdentifierRegex.MatchString(name) {
+			return fmt.Errorf("invalid table '%s' in 'spec.ignoreTables', it must be in 'database.table' format "+
+				"and only alphanumeric characters and '_' are allowed", table)
+		}
+	}
+	return nil
+}
+
 func (b *Restore) SetDefaults(mariadb *MariaDB) {
 	if b.Spec.BackoffLimit == 0 {
 		b.Spec.BackoffLimit = 5
@@ -42,5 +42,11 @@ func (r *Restore) validate() (admission.Warnings, error) {
 	if err := r.Spec.RestoreSource.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid restore: %v", err)
 	}
+	if err := r.validateTargetDatabase(); err != nil {
+		return nil, fmt.Errorf("invalid restore: %v", err)
+	}
+	if err := r.validateIgnored(); err != nil {
+		return nil, fmt.Errorf("invalid restore: %v", err)
+	}
 	return nil, nil
 }
@@ -234,6 +234,142 @@ var _ = Describe("Restore webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Target database",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &LocalObjectReference{
+								Name: "backup-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						Database:       "app",
+						TargetDatabase: "app_staging",
+						BackoffLimit:   10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Target database without database",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &LocalObjectReference{
+								Name: "backup-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						TargetDatabase: "app_staging",
+						BackoffLimit:   10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid target database",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &LocalObjectReference{
+								Name: "backup-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						Database:       "app",
+						TargetDatabase: "app-staging",
+						BackoffLimit:   10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Ignore databases and tables",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &LocalObjectReference{
+								Name: "backup-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						IgnoreDatabases: []string{"audit"},
+						IgnoreTables:    []string{"app.sessions"},
+						BackoffLimit:    10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid ignored table",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &LocalObjectReference{
+								Name: "backup-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						IgnoreTables: []string{"sessions"},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Restored and ignored database",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &LocalObjectReference{
+								Name: "backup-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						Database:        "app",
+						IgnoreDatabases: []string{"app"},
+						BackoffLimit:    10,
+					},
+				},
+				true,
+			),
 		)
 	})
 
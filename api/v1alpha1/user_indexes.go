@@ -92,7 +92,7 @@ func IndexUser(ctx context.Context, mgr manager.Manager, builder *ctrlbuilder.Bu
 			&UserList{},
 			fieldPath,
 			ctrlbuilder.WithPredicates(
-				predicate.PredicateWithLabel(metadata.WatchLabel),
+				predicate.PredicateWithLabelSelector(metadata.WatchLabelSelector()),
 			),
 		); err != nil {
 			return fmt.Errorf("error watching: %v", err)
@@ -2,7 +2,6 @@ package v1alpha1
 
 import (
 	"errors"
-	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -130,10 +129,6 @@ type User struct {
 	Status UserStatus `json:"status,omitempty"`
 }
 
-func (u *User) AccountName() string {
-	return fmt.Sprintf("'%s'@'%s'", u.UsernameOrDefault(), u.HostnameOrDefault())
-}
-
 func (u *User) UsernameOrDefault() string {
 	if u.Spec.Name != "" {
 		return u.Spec.Name
@@ -38,6 +38,22 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalVolumeClaimTemplate) DeepCopyInto(out *AdditionalVolumeClaimTemplate) {
+	*out = *in
+	in.VolumeClaimTemplate.DeepCopyInto(&out.VolumeClaimTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalVolumeClaimTemplate.
+func (in *AdditionalVolumeClaimTemplate) DeepCopy() *AdditionalVolumeClaimTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalVolumeClaimTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Affinity) DeepCopyInto(out *Affinity) {
 	*out = *in
@@ -672,6 +688,11 @@ func (in *ContainerTemplate) DeepCopyInto(out *ContainerTemplate) {
 		*out = new(Probe)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(ResourceRequirements)
@@ -1060,6 +1081,16 @@ func (in *GaleraBootstrapStatus) DeepCopyInto(out *GaleraBootstrapStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Seqno != nil {
+		in, out := &in.Seqno, &out.Seqno
+		*out = new(int)
+		**out = **in
+	}
+	if in.UUID != nil {
+		in, out := &in.UUID, &out.UUID
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GaleraBootstrapStatus.
@@ -1181,6 +1212,21 @@ func (in *GaleraRecovery) DeepCopyInto(out *GaleraRecovery) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.PodSyncInitialDelay != nil {
+		in, out := &in.PodSyncInitialDelay, &out.PodSyncInitialDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AgentTimeout != nil {
+		in, out := &in.AgentTimeout, &out.AgentTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ForceClusterBootstrapInPod != nil {
 		in, out := &in.ForceClusterBootstrapInPod, &out.ForceClusterBootstrapInPod
 		*out = new(string)
@@ -1496,6 +1542,31 @@ func (in *HealthCheck) DeepCopy() *HealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitSQL) DeepCopyInto(out *InitSQL) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitSQL.
+func (in *InitSQL) DeepCopy() *InitSQL {
+	if in == nil {
+		return nil
+	}
+	out := new(InitSQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Job) DeepCopyInto(out *Job) {
 	*out = *in
@@ -1684,6 +1755,66 @@ func (in *LabelSelectorRequirement) DeepCopy() *LabelSelectorRequirement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
+	*out = *in
+	if in.PostStart != nil {
+		in, out := &in.PostStart, &out.PostStart
+		*out = new(LifecycleHandler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreStop != nil {
+		in, out := &in.PreStop, &out.PreStop
+		*out = new(LifecycleHandler)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lifecycle.
+func (in *Lifecycle) DeepCopy() *Lifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(Lifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleHandler) DeepCopyInto(out *LifecycleHandler) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(HTTPGetAction)
+		**out = **in
+	}
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(TCPSocketAction)
+		**out = **in
+	}
+	if in.Sleep != nil {
+		in, out := &in.Sleep, &out.Sleep
+		*out = new(SleepAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHandler.
+func (in *LifecycleHandler) DeepCopy() *LifecycleHandler {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleHandler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
 	*out = *in
@@ -1923,6 +2054,11 @@ func (in *MariaDBSpec) DeepCopyInto(out *MariaDBSpec) {
 		*out = new(BootstrapFrom)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InitSQL != nil {
+		in, out := &in.InitSQL, &out.InitSQL
+		*out = new(InitSQL)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Storage.DeepCopyInto(&out.Storage)
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
@@ -3203,6 +3339,16 @@ func (in *PodTemplate) DeepCopyInto(out *PodTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologySpreadZoneEnabled != nil {
+		in, out := &in.TopologySpreadZoneEnabled, &out.TopologySpreadZoneEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TopologySpreadHostnameEnabled != nil {
+		in, out := &in.TopologySpreadHostnameEnabled, &out.TopologySpreadHostnameEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplate.
@@ -3578,6 +3724,16 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	in.JobPodTemplate.DeepCopyInto(&out.JobPodTemplate)
 	in.RestoreSource.DeepCopyInto(&out.RestoreSource)
 	out.MariaDBRef = in.MariaDBRef
+	if in.IgnoreDatabases != nil {
+		in, out := &in.IgnoreDatabases, &out.IgnoreDatabases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreTables != nil {
+		in, out := &in.IgnoreTables, &out.IgnoreTables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.InheritMetadata != nil {
 		in, out := &in.InheritMetadata, &out.InheritMetadata
 		*out = new(Metadata)
@@ -3913,6 +4069,21 @@ func (in *ServiceTemplate) DeepCopy() *ServiceTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SleepAction) DeepCopyInto(out *SleepAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SleepAction.
+func (in *SleepAction) DeepCopy() *SleepAction {
+	if in == nil {
+		return nil
+	}
+	out := new(SleepAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SqlJob) DeepCopyInto(out *SqlJob) {
 	*out = *in
@@ -4077,11 +4248,23 @@ func (in *Storage) DeepCopyInto(out *Storage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PersistentVolumeClaimRetentionPolicy != nil {
+		in, out := &in.PersistentVolumeClaimRetentionPolicy, &out.PersistentVolumeClaimRetentionPolicy
+		*out = new(appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy)
+		**out = **in
+	}
 	if in.VolumeClaimTemplate != nil {
 		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
 		*out = new(VolumeClaimTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalVolumeClaimTemplates != nil {
+		in, out := &in.AdditionalVolumeClaimTemplates, &out.AdditionalVolumeClaimTemplates
+		*out = make([]AdditionalVolumeClaimTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
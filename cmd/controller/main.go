@@ -35,6 +35,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/log"
 	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
+	sqlClient "github.com/mariadb-operator/mariadb-operator/pkg/sql"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -78,6 +79,12 @@ var (
 	webhookCertDir string
 
 	featureMaxScaleSuspend bool
+
+	watchLabel      string
+	watchLabelValue string
+
+	systemSchemas        []string
+	maxClientsPerMariaDB int
 )
 
 func init() {
@@ -119,6 +126,20 @@ func init() {
 			"This only applies if the webhook server is enabled.")
 
 	rootCmd.Flags().BoolVar(&featureMaxScaleSuspend, "feature-maxscale-suspend", false, "Feature flag to enable MaxScale resource suspension.")
+
+	rootCmd.Flags().StringVar(&watchLabel, "watch-label", metadata.WatchLabel,
+		"Label used to watch external resources, such as Secrets and ConfigMaps. "+
+			"Useful to isolate multiple operator instances running in the same cluster.")
+	rootCmd.Flags().StringVar(&watchLabelValue, "watch-label-value", metadata.WatchLabelValue,
+		"Value of the label used to watch external resources. If empty, the presence of the label is enough.")
+
+	rootCmd.Flags().StringSliceVar(&systemSchemas, "system-schemas", nil,
+		"Additional system schemas to exclude from Database reconciliation, on top of information_schema, mysql, performance_schema and sys.")
+	rootCmd.Flags().IntVar(&maxClientsPerMariaDB, "max-clients-per-mariadb", 0,
+		"Maximum number of concurrent SQL clients per MariaDB, bounding the connections opened by the operator. Zero means unbounded. "+
+			"Slots are held until the clients are closed and waiting for a slot times out after 30s, "+
+			"so a low limit may cause reconciliation errors when many reconcilers target the same MariaDB. "+
+			"Per-Pod clients used by replication and Galera are not bounded.")
 }
 
 var rootCmd = &cobra.Command{
@@ -148,6 +169,18 @@ var rootCmd = &cobra.Command{
 			setupLog.Error(err, "Error getting environment")
 			os.Exit(1)
 		}
+		if err := metadata.SetWatchLabel(watchLabel, watchLabelValue); err != nil {
+			setupLog.Error(err, "Invalid watch label")
+			os.Exit(1)
+		}
+		if err := sqlClient.SetSystemSchemas(systemSchemas); err != nil {
+			setupLog.Error(err, "Invalid system schemas")
+			os.Exit(1)
+		}
+		if err := sqlClient.SetMaxClientsPerMariaDB(maxClientsPerMariaDB); err != nil {
+			setupLog.Error(err, "Invalid max clients per MariaDB")
+			os.Exit(1)
+		}
 
 		mgrOpts := ctrl.Options{
 			Scheme: scheme,
@@ -9,6 +9,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/sql"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	sqlClient "github.com/mariadb-operator/mariadb-operator/pkg/sql"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -84,6 +85,10 @@ func newWrappedGrantReconciler(client client.Client, refResolver refresolver.Ref
 }
 
 func (wr *wrappedGrantReconciler) Reconcile(ctx context.Context, mdbClient *sqlClient.Client) error {
+	if err := wr.revokeOrphanedGrants(ctx, mdbClient); err != nil {
+		return fmt.Errorf("error revoking orphaned grants: %v", err)
+	}
+
 	var opts []sqlClient.GrantOption
 	if wr.grant.Spec.GrantOption {
 		opts = append(opts, sqlClient.WithGrantOption())
@@ -93,7 +98,7 @@ func (wr *wrappedGrantReconciler) Reconcile(ctx context.Context, mdbClient *sqlC
 		wr.grant.Spec.Privileges,
 		wr.grant.Spec.Database,
 		wr.grant.Spec.Table,
-		wr.grant.AccountName(),
+		sqlClient.NewAccount(wr.grant.Spec.Username, wr.grant.HostnameOrDefault()),
 		opts...,
 	); err != nil {
 		return fmt.Errorf("error granting privileges in MariaDB: %v", err)
@@ -101,6 +106,46 @@ func (wr *wrappedGrantReconciler) Reconcile(ctx context.Context, mdbClient *sqlC
 	return nil
 }
 
+// revokeOrphanedGrants revokes the privileges of the account that no longer have a corresponding Grant.
+// Only accounts managed by a User are considered, leaving the rest of accounts untouched.
+func (wr *wrappedGrantReconciler) revokeOrphanedGrants(ctx context.Context, mdbClient *sqlClient.Client) error {
+	account := sqlClient.NewAccount(wr.grant.Spec.Username, wr.grant.HostnameOrDefault())
+
+	var user mariadbv1alpha1.User
+	if err := wr.Get(ctx, userKey(wr.grant), &user); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting User: %v", err)
+	}
+	if sqlClient.NewAccount(user.UsernameOrDefault(), user.HostnameOrDefault()) != account {
+		return nil
+	}
+
+	// Grants in other namespaces may refer to the same account and MariaDB via a cross-namespace mariaDbRef,
+	// therefore all namespaces are listed and desiredGrants filters by MariaDB.
+	var grantList mariadbv1alpha1.GrantList
+	if err := wr.List(ctx, &grantList); err != nil {
+		return fmt.Errorf("error listing Grants: %v", err)
+	}
+	desired, err := desiredGrants(wr.grant, grantList.Items)
+	if err != nil {
+		return fmt.Errorf("error getting desired grants: %v", err)
+	}
+
+	actual, err := mdbClient.ListGrantsForAccount(ctx, account)
+	if err != nil {
+		return fmt.Errorf("error listing grants: %v", err)
+	}
+
+	for _, orphaned := range sqlClient.OrphanedGrants(desired, actual) {
+		if err := mdbClient.RevokeGrant(ctx, orphaned, account); err != nil {
+			return fmt.Errorf("error revoking grant on %s: %v", orphaned.Object, err)
+		}
+	}
+	return nil
+}
+
 func (wr *wrappedGrantReconciler) PatchStatus(ctx context.Context, patcher condition.Patcher) error {
 	patch := client.MergeFrom(wr.grant.DeepCopy())
 	patcher(&wr.grant.Status)
@@ -111,6 +156,35 @@ func (wr *wrappedGrantReconciler) PatchStatus(ctx context.Context, patcher condi
 	return nil
 }
 
+// desiredGrants returns the grants of the Grants that belong to the same account and MariaDB as the given Grant.
+// Grants being deleted are not considered, as their privileges are revoked by the finalizer.
+func desiredGrants(grant *mariadbv1alpha1.Grant, grants []mariadbv1alpha1.Grant) ([]sqlClient.Grant, error) {
+	var desired []sqlClient.Grant
+	for _, g := range grants {
+		if g.IsBeingDeleted() || g.Spec.Username != grant.Spec.Username || g.HostnameOrDefault() != grant.HostnameOrDefault() ||
+			grantMariaDBKey(&g) != grantMariaDBKey(grant) {
+			continue
+		}
+		sqlGrant, err := sqlClient.NewGrant(g.Spec.Privileges, g.Spec.Database, g.Spec.Table, g.Spec.GrantOption)
+		if err != nil {
+			return nil, fmt.Errorf("error getting grant from Grant '%s': %v", g.Name, err)
+		}
+		desired = append(desired, sqlGrant)
+	}
+	return desired, nil
+}
+
+func grantMariaDBKey(grant *mariadbv1alpha1.Grant) types.NamespacedName {
+	key := types.NamespacedName{
+		Name:      grant.Spec.MariaDBRef.Name,
+		Namespace: grant.Namespace,
+	}
+	if grant.Spec.MariaDBRef.Namespace != "" {
+		key.Namespace = grant.Spec.MariaDBRef.Namespace
+	}
+	return key
+}
+
 func userKey(grant *mariadbv1alpha1.Grant) types.NamespacedName {
 	return types.NamespacedName{
 		Name:      grant.Spec.Username,
@@ -80,7 +80,7 @@ func (wf *wrappedGrantFinalizer) Reconcile(ctx context.Context, mdbClient *sqlCl
 		wf.grant.Spec.Privileges,
 		wf.grant.Spec.Database,
 		wf.grant.Spec.Table,
-		wf.grant.AccountName(),
+		sqlClient.NewAccount(wf.grant.Spec.Username, wf.grant.HostnameOrDefault()),
 		opts...,
 	); err != nil {
 		return fmt.Errorf("error revoking grant in MariaDB: %v", err)
@@ -32,6 +32,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/health"
 	kadapter "github.com/mariadb-operator/mariadb-operator/pkg/kubernetes/adapter"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	mdbpod "github.com/mariadb-operator/mariadb-operator/pkg/pod"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	sts "github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
@@ -123,6 +124,10 @@ func (r *MariaDBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	phases := []reconcilePhaseMariaDB{
+		{
+			Name:      "Pause",
+			Reconcile: r.reconcilePause,
+		},
 		{
 			Name:      "Spec",
 			Reconcile: r.setSpecDefaults,
@@ -195,6 +200,10 @@ func (r *MariaDBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			Name:      "SQL",
 			Reconcile: r.reconcileSQL,
 		},
+		{
+			Name:      "InitSQL",
+			Reconcile: r.reconcileInitSQL,
+		},
 		{
 			Name:      "Metrics",
 			Reconcile: r.reconcileMetrics,
@@ -246,9 +255,22 @@ func shouldSkipPhase(err error) bool {
 }
 
 func requeueResult(ctx context.Context, mdb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	var requeueAfter time.Duration
 	if mdb.IsTLSEnabled() {
+		requeueAfter = 5 * time.Minute // ensure certificates get renewed
+	}
+
+	interval, err := metadata.ReconcileInterval(mdb.Annotations)
+	if err != nil {
+		log.FromContext(ctx).Info("Invalid reconcile interval. Using default", "err", err)
+	}
+	if interval != nil && (requeueAfter == 0 || *interval < requeueAfter) {
+		requeueAfter = *interval
+	}
+
+	if requeueAfter > 0 {
 		log.FromContext(ctx).V(1).Info("Requeuing MariaDB")
-		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil // ensure certificates get renewed
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 	return ctrl.Result{}, nil
 }
@@ -881,6 +903,29 @@ func (r *MariaDBReconciler) setSpecDefaults(ctx context.Context, mariadb *mariad
 	})
 }
 
+func (r *MariaDBReconciler) reconcilePause(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	if mariadb.IsPaused() {
+		log.FromContext(ctx).V(1).Info("MariaDB is paused. Skipping...")
+		if err := r.patchStatus(ctx, mariadb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+			condition.SetPaused(status)
+			return nil
+		}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error patching MariaDB status: %v", err)
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if mariadb.HasPausedCondition() {
+		log.FromContext(ctx).Info("Resuming MariaDB reconciliation")
+		if err := r.patchStatus(ctx, mariadb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+			condition.SetResumed(status)
+			return nil
+		}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error patching MariaDB status: %v", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
 func (r *MariaDBReconciler) reconcileSuspend(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
 	if mariadb.IsSuspended() {
 		log.FromContext(ctx).V(1).Info("MariaDB is suspended. Skipping...")
@@ -903,7 +948,7 @@ func (r *MariaDBReconciler) reconcileConnection(ctx context.Context, mariadb *ma
 	}
 	if mariadb.IsHAEnabled() {
 		if mariadb.Spec.PrimaryConnection != nil {
-			key := mariadb.PrimaryConnectioneKey()
+			key := mariadb.PrimaryConnectionKey()
 			connTpl := mariadb.Spec.PrimaryConnection
 			connTpl.ServiceName = ptr.To(mariadb.PrimaryServiceKey().Name)
 
@@ -912,7 +957,7 @@ func (r *MariaDBReconciler) reconcileConnection(ctx context.Context, mariadb *ma
 			}
 		}
 		if mariadb.Spec.SecondaryConnection != nil {
-			key := mariadb.SecondaryConnectioneKey()
+			key := mariadb.SecondaryConnectionKey()
 			connTpl := mariadb.Spec.SecondaryConnection
 			connTpl.ServiceName = ptr.To(mariadb.SecondaryServiceKey().Name)
 
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
+	sqlClient "github.com/mariadb-operator/mariadb-operator/pkg/sql"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func shouldReconcileInitSQL(mdb *mariadbv1alpha1.MariaDB) bool {
+	if mdb.Spec.InitSQL == nil || mdb.HasAppliedInitSQL() {
+		return false
+	}
+	if mdb.IsUpdating() || mdb.IsResizingStorage() || mdb.IsSwitchingPrimary() || mdb.HasGaleraNotReadyCondition() {
+		return false
+	}
+	return true
+}
+
+func (r *MariaDBReconciler) reconcileInitSQL(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	if !shouldReconcileInitSQL(mariadb) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx).WithName("init-sql")
+
+	if !mariadb.IsReady() || (mariadb.Spec.BootstrapFrom != nil && !mariadb.HasRestoredBackup()) {
+		logger.V(1).Info("MariaDB not bootstrapped. Requeuing init SQL")
+		return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+	}
+
+	statements, err := r.initSQLStatements(ctx, mariadb)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting init SQL statements: %v", err)
+	}
+	if strings.TrimSpace(statements) != "" {
+		mdbClient, err := sqlClient.NewClientWithMariaDB(ctx, mariadb, r.RefResolver, sqlClient.WithMultiStatements(true))
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error connecting to MariaDB: %v", err)
+		}
+		defer mdbClient.Close()
+
+		logger.Info("Running init SQL")
+		if err := mdbClient.ExecMulti(ctx, []string{statements}, sqlClient.WithTransaction()); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error running init SQL: %v", err)
+		}
+	}
+
+	return ctrl.Result{}, r.patchStatus(ctx, mariadb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+		condition.SetInitSQLApplied(status)
+		return nil
+	})
+}
+
+func (r *MariaDBReconciler) initSQLStatements(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (string, error) {
+	initSQL := mariadb.Spec.InitSQL
+	if initSQL.ConfigMapKeyRef != nil {
+		return r.RefResolver.ConfigMapKeyRef(ctx, initSQL.ConfigMapKeyRef, mariadb.Namespace)
+	}
+	if initSQL.SecretKeyRef != nil {
+		return r.RefResolver.SecretKeyRef(ctx, *initSQL.SecretKeyRef, mariadb.Namespace)
+	}
+	return "", errors.New("either ConfigMap or Secret reference must be set")
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("MariaDB init SQL", func() {
+	initSQL := &mariadbv1alpha1.InitSQL{
+		ConfigMapKeyRef: &mariadbv1alpha1.ConfigMapKeySelector{
+			LocalObjectReference: mariadbv1alpha1.LocalObjectReference{
+				Name: "init-sql",
+			},
+			Key: "init.sql",
+		},
+	}
+
+	DescribeTable("should run once",
+		func(mdb *mariadbv1alpha1.MariaDB, wantReconcile bool) {
+			Expect(shouldReconcileInitSQL(mdb)).To(Equal(wantReconcile))
+		},
+		Entry(
+			"No InitSQL",
+			&mariadbv1alpha1.MariaDB{},
+			false,
+		),
+		Entry(
+			"Pending InitSQL",
+			&mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					InitSQL: initSQL,
+				},
+			},
+			true,
+		),
+		Entry(
+			"Applied InitSQL",
+			&mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					InitSQL: initSQL,
+				},
+				Status: mariadbv1alpha1.MariaDBStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   mariadbv1alpha1.ConditionTypeInitSQLApplied,
+							Status: metav1.ConditionTrue,
+							Reason: mariadbv1alpha1.ConditionReasonInitSQLApplied,
+						},
+					},
+				},
+			},
+			false,
+		),
+		Entry(
+			"Pending InitSQL while updating",
+			&mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					InitSQL: initSQL,
+				},
+				Status: mariadbv1alpha1.MariaDBStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   mariadbv1alpha1.ConditionTypeUpdated,
+							Status: metav1.ConditionFalse,
+							Reason: mariadbv1alpha1.ConditionReasonUpdating,
+						},
+					},
+				},
+			},
+			false,
+		),
+		Entry(
+			"Pending InitSQL while switching primary",
+			&mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					InitSQL: initSQL,
+				},
+				Status: mariadbv1alpha1.MariaDBStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   mariadbv1alpha1.ConditionTypePrimarySwitched,
+							Status: metav1.ConditionFalse,
+							Reason: mariadbv1alpha1.ConditionReasonSwitchPrimary,
+						},
+					},
+				},
+			},
+			false,
+		),
+	)
+})
@@ -185,7 +185,7 @@ var _ = Describe("MariaDB replication", Ordered, func() {
 		By("Expecting primary Connection to be ready eventually")
 		Eventually(func() bool {
 			var conn mariadbv1alpha1.Connection
-			if err := k8sClient.Get(testCtx, mdb.PrimaryConnectioneKey(), &conn); err != nil {
+			if err := k8sClient.Get(testCtx, mdb.PrimaryConnectionKey(), &conn); err != nil {
 				return false
 			}
 			return conn.IsReady()
@@ -194,7 +194,7 @@ var _ = Describe("MariaDB replication", Ordered, func() {
 		By("Expecting secondary Connection to be ready eventually")
 		Eventually(func() bool {
 			var conn mariadbv1alpha1.Connection
-			if err := k8sClient.Get(testCtx, mdb.SecondaryConnectioneKey(), &conn); err != nil {
+			if err := k8sClient.Get(testCtx, mdb.SecondaryConnectionKey(), &conn); err != nil {
 				return false
 			}
 			return conn.IsReady()
@@ -264,7 +264,7 @@ var _ = Describe("MariaDB replication", Ordered, func() {
 		By("Expecting primary Connection to be ready eventually")
 		Eventually(func() bool {
 			var conn mariadbv1alpha1.Connection
-			if err := k8sClient.Get(testCtx, mdb.PrimaryConnectioneKey(), &conn); err != nil {
+			if err := k8sClient.Get(testCtx, mdb.PrimaryConnectionKey(), &conn); err != nil {
 				return false
 			}
 			return conn.IsReady()
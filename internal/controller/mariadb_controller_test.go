@@ -13,6 +13,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -131,6 +132,72 @@ var _ = Describe("MariaDB", func() {
 		})
 	})
 
+	It("should pause", func() {
+		By("Creating MariaDB")
+		key := types.NamespacedName{
+			Name:      "test-mariadb-pause",
+			Namespace: testNamespace,
+		}
+		mdb := mariadbv1alpha1.MariaDB{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Annotations: map[string]string{
+					metadata.PausedAnnotation: "true",
+				},
+			},
+			Spec: mariadbv1alpha1.MariaDBSpec{
+				Storage: mariadbv1alpha1.Storage{
+					Size: ptr.To(resource.MustParse("300Mi")),
+				},
+			},
+		}
+		Expect(k8sClient.Create(testCtx, &mdb)).To(Succeed())
+		DeferCleanup(func() {
+			deleteMariadb(key, false)
+		})
+
+		By("Expecting MariaDB to eventually be paused")
+		expectMariadbFn(testCtx, k8sClient, key, func(mdb *mariadbv1alpha1.MariaDB) bool {
+			condition := meta.FindStatusCondition(mdb.Status.Conditions, mariadbv1alpha1.ConditionTypePaused)
+			if condition == nil {
+				return false
+			}
+			return condition.Status == metav1.ConditionTrue && condition.Reason == mariadbv1alpha1.ConditionReasonPaused
+		})
+
+		By("Expecting StatefulSet not to be created")
+		Consistently(func() bool {
+			var sts appsv1.StatefulSet
+			return apierrors.IsNotFound(k8sClient.Get(testCtx, key, &sts))
+		}, 5*time.Second, testInterval).Should(BeTrue())
+
+		By("Resume MariaDB")
+		Eventually(func() bool {
+			if err := k8sClient.Get(testCtx, key, &mdb); err != nil {
+				return false
+			}
+			delete(mdb.Annotations, metadata.PausedAnnotation)
+
+			return k8sClient.Update(testCtx, &mdb) == nil
+		}, testTimeout, testInterval).Should(BeTrue())
+
+		By("Expecting MariaDB to eventually be resumed")
+		expectMariadbFn(testCtx, k8sClient, key, func(mdb *mariadbv1alpha1.MariaDB) bool {
+			condition := meta.FindStatusCondition(mdb.Status.Conditions, mariadbv1alpha1.ConditionTypePaused)
+			if condition == nil {
+				return false
+			}
+			return condition.Status == metav1.ConditionFalse && condition.Reason == mariadbv1alpha1.ConditionReasonResumed
+		})
+
+		By("Expecting StatefulSet to eventually be created")
+		Eventually(func() bool {
+			var sts appsv1.StatefulSet
+			return k8sClient.Get(testCtx, key, &sts) == nil
+		}, testTimeout, testInterval).Should(BeTrue())
+	})
+
 	It("should reconcile", func() {
 		var testMariaDb mariadbv1alpha1.MariaDB
 		By("Getting MariaDB")
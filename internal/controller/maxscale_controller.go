@@ -25,6 +25,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
 	mxsclient "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/client"
 	mxsconfig "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/config"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/pod"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	stsobj "github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
@@ -563,7 +564,7 @@ func (r *MaxScaleReconciler) reconcileInternalService(ctx context.Context, maxsc
 }
 
 func (r *MaxScaleReconciler) reconcileKubernetesService(ctx context.Context, maxscale *mariadbv1alpha1.MaxScale) error {
-	key := client.ObjectKeyFromObject(maxscale)
+	key := maxscale.ServiceKey()
 	selectorLabels :=
 		labels.NewLabelsBuilder().
 			WithMaxScaleSelectorLabels(maxscale).
@@ -1405,6 +1406,14 @@ func (r *MaxScaleReconciler) patch(ctx context.Context, maxscale *mariadbv1alpha
 }
 
 func (r *MaxScaleReconciler) requeueResult(ctx context.Context, mxs *mariadbv1alpha1.MaxScale) (ctrl.Result, error) {
+	interval, err := metadata.ReconcileInterval(mxs.Annotations)
+	if err != nil {
+		log.FromContext(ctx).Info("Invalid reconcile interval. Using default", "err", err)
+	}
+	if interval != nil {
+		log.FromContext(ctx).V(1).Info("Requeuing MaxScale")
+		return ctrl.Result{RequeueAfter: *interval}, nil
+	}
 	if mxs.Spec.RequeueInterval != nil {
 		log.FromContext(ctx).V(1).Info("Requeuing MaxScale")
 		return ctrl.Result{RequeueAfter: mxs.Spec.RequeueInterval.Duration}, nil
@@ -128,13 +128,15 @@ func (wr *wrappedUserReconciler) Reconcile(ctx context.Context, mdbClient *sqlCl
 
 	if wr.user.Spec.Require != nil {
 		createUserOpts = append(createUserOpts, sqlClient.WithTLSRequirements(wr.user.Spec.Require))
+	} else {
+		createUserOpts = append(createUserOpts, sqlClient.WithRequireNone())
 	}
 
 	createUserOpts = append(createUserOpts, sqlClient.WithMaxUserConnections(wr.user.Spec.MaxUserConnections))
 
 	username := wr.user.UsernameOrDefault()
 	hostname := wr.user.HostnameOrDefault()
-	accountName := wr.user.AccountName()
+	account := sqlClient.NewAccount(username, hostname)
 
 	exists, err := mdbClient.UserExists(ctx, username, hostname)
 	if err != nil {
@@ -144,14 +146,14 @@ func (wr *wrappedUserReconciler) Reconcile(ctx context.Context, mdbClient *sqlCl
 	if !exists {
 		// This forces the user to be recreated from a clean state.
 		// It helps fixing intermediate states in mysql.global_priv and mysql.user.
-		if err := mdbClient.DropUser(ctx, accountName); err != nil {
+		if err := mdbClient.DropUser(ctx, account); err != nil {
 			return fmt.Errorf("error dropping User: %v", err)
 		}
-		if err := mdbClient.CreateUser(ctx, accountName, createUserOpts...); err != nil {
+		if err := mdbClient.CreateUser(ctx, account, createUserOpts...); err != nil {
 			return fmt.Errorf("error creating User: %v", err)
 		}
 	} else if password != "" || passwordHash != "" || passwordVia != "" {
-		if err := mdbClient.AlterUser(ctx, accountName, createUserOpts...); err != nil {
+		if err := mdbClient.AlterUser(ctx, account, createUserOpts...); err != nil {
 			return fmt.Errorf("error altering User: %v", err)
 		}
 	}
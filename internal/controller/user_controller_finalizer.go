@@ -50,7 +50,7 @@ func (wf *wrappedUserFinalizer) ContainsFinalizer() bool {
 }
 
 func (wf *wrappedUserFinalizer) Reconcile(ctx context.Context, mdbClient *sqlClient.Client) error {
-	if err := mdbClient.DropUser(ctx, wf.user.AccountName()); err != nil {
+	if err := mdbClient.DropUser(ctx, sqlClient.NewAccount(wf.user.UsernameOrDefault(), wf.user.HostnameOrDefault())); err != nil {
 		return fmt.Errorf("error dropping user in MariaDB: %v", err)
 	}
 	return nil
@@ -215,6 +215,24 @@ func (b *Builder) BuildRestoreJob(key types.NamespacedName, restore *mariadbv1al
 		return nil, err
 	}
 
+	initContainers := []corev1.Container{*operatorContainer}
+	if restore.Spec.VerifyBackup {
+		verifyContainer, err := b.jobContainer(
+			"verify-backup",
+			cmd.MariadbVerifyBackup(),
+			mariadb.Spec.Image,
+			volumeSources,
+			nil,
+			jobResources(restore.Spec.Resources),
+			mariadb,
+			restore.Spec.SecurityContext,
+		)
+		if err != nil {
+			return nil, err
+		}
+		initContainers = append(initContainers, *verifyContainer)
+	}
+
 	mariadbContainer, err := b.jobMariadbContainer(
 		cmd.MariadbRestore(restore, mariadb),
 		volumeSources,
@@ -242,7 +260,7 @@ func (b *Builder) BuildRestoreJob(key types.NamespacedName, restore *mariadbv1al
 					RestartPolicy:      restore.Spec.RestartPolicy,
 					ImagePullSecrets:   batchImagePullSecrets(mariadb, restore.Spec.ImagePullSecrets),
 					Volumes:            volumes,
-					InitContainers:     []corev1.Container{*operatorContainer},
+					InitContainers:     initContainers,
 					Containers:         []corev1.Container{*mariadbContainer},
 					Affinity:           ptr.To(affinity.ToKubernetesType()),
 					NodeSelector:       restore.Spec.NodeSelector,
@@ -317,6 +335,7 @@ func (b *Builder) BuilGaleraInitJob(key types.NamespacedName, mariadb *mariadbv1
 		withServiceAccount(false),
 		withPorts(false),
 		withProbes(false),
+		withLifecycle(false),
 		withHAAnnotations(false),
 	}
 
@@ -406,6 +425,7 @@ func (b *Builder) BuildGaleraRecoveryJob(key types.NamespacedName, mariadb *mari
 		withServiceAccount(false),
 		withPorts(false),
 		withProbes(false),
+		withLifecycle(false),
 		withHAAnnotations(false),
 	}
 	if podAffinityEnabled {
@@ -2,10 +2,12 @@ package builder
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	galeraresources "github.com/mariadb-operator/mariadb-operator/pkg/controller/galera/resources"
+	"github.com/mariadb-operator/mariadb-operator/pkg/datastructures"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -517,6 +519,189 @@ func TestRestoreJobImagePullSecrets(t *testing.T) {
 	}
 }
 
+func TestRestoreJobVerifyBackup(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name:      "restore-verify-backup",
+		Namespace: "test",
+	}
+
+	tests := []struct {
+		name               string
+		verifyBackup       bool
+		wantInitContainers []string
+	}{
+		{
+			name:               "No verification",
+			verifyBackup:       false,
+			wantInitContainers: []string{"mariadb-operator"},
+		},
+		{
+			name:               "Verification",
+			verifyBackup:       true,
+			wantInitContainers: []string{"mariadb-operator", "verify-backup"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := &mariadbv1alpha1.Restore{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.RestoreSpec{
+					MariaDBRef: mariadbv1alpha1.MariaDBRef{
+						ObjectReference: mariadbv1alpha1.ObjectReference{
+							Name: objMeta.Name,
+						},
+					},
+					RestoreSource: mariadbv1alpha1.RestoreSource{
+						Volume: &mariadbv1alpha1.StorageVolumeSource{},
+					},
+					VerifyBackup: tt.verifyBackup,
+				},
+			}
+			mariadb := &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Image: "mariadb:11.4",
+				},
+			}
+
+			job, err := builder.BuildRestoreJob(client.ObjectKeyFromObject(restore), restore, mariadb)
+			if err != nil {
+				t.Fatalf("unexpected error building Job: %v", err)
+			}
+
+			initContainers := job.Spec.Template.Spec.InitContainers
+			var names []string
+			for _, c := range initContainers {
+				names = append(names, c.Name)
+			}
+			if !reflect.DeepEqual(tt.wantInitContainers, names) {
+				t.Fatalf("unexpected init containers, want: %v got: %v", tt.wantInitContainers, names)
+			}
+			if !tt.verifyBackup {
+				return
+			}
+
+			verifyContainer := initContainers[len(initContainers)-1]
+			if verifyContainer.Image != mariadb.Spec.Image {
+				t.Errorf("unexpected verify container image, want: %s got: %s", mariadb.Spec.Image, verifyContainer.Image)
+			}
+			if len(verifyContainer.Args) != 1 || !strings.Contains(verifyContainer.Args[0], "-- Dump completed") {
+				t.Errorf("expected verify container to check the backup trailer, got args: %v", verifyContainer.Args)
+			}
+			if !reflect.DeepEqual(job.Spec.Template.Spec.Containers[0].VolumeMounts, verifyContainer.VolumeMounts) {
+				t.Errorf("expected verify container to mount the backup volumes, got: %v", verifyContainer.VolumeMounts)
+			}
+		})
+	}
+}
+
+func TestRestoreJobStagingStorage(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name:      "restore-staging-storage",
+		Namespace: "test",
+	}
+	s3 := &mariadbv1alpha1.S3{
+		Bucket:   "backups",
+		Endpoint: "minio:9000",
+	}
+
+	tests := []struct {
+		name           string
+		stagingStorage *mariadbv1alpha1.BackupStagingStorage
+		wantVolume     corev1.VolumeSource
+	}{
+		{
+			name:           "Default",
+			stagingStorage: nil,
+			wantVolume: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+		{
+			name: "PVC",
+			stagingStorage: &mariadbv1alpha1.BackupStagingStorage{
+				PersistentVolumeClaim: &mariadbv1alpha1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{
+						corev1.ReadWriteOnce,
+					},
+				},
+			},
+			wantVolume: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "restore-staging-storage-staging",
+				},
+			},
+		},
+		{
+			name: "Volume",
+			stagingStorage: &mariadbv1alpha1.BackupStagingStorage{
+				Volume: &mariadbv1alpha1.StorageVolumeSource{
+					EmptyDir: &mariadbv1alpha1.EmptyDirVolumeSource{
+						Medium: corev1.StorageMediumMemory,
+					},
+				},
+			},
+			wantVolume: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := &mariadbv1alpha1.Restore{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.RestoreSpec{
+					MariaDBRef: mariadbv1alpha1.MariaDBRef{
+						ObjectReference: mariadbv1alpha1.ObjectReference{
+							Name: objMeta.Name,
+						},
+					},
+					RestoreSource: mariadbv1alpha1.RestoreSource{
+						S3:             s3,
+						StagingStorage: tt.stagingStorage,
+					},
+				},
+			}
+			restore.Spec.RestoreSource.SetDefaults(restore)
+			mariadb := &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+			}
+
+			job, err := builder.BuildRestoreJob(client.ObjectKeyFromObject(restore), restore, mariadb)
+			if err != nil {
+				t.Fatalf("unexpected error building Job: %v", err)
+			}
+			podSpec := job.Spec.Template.Spec
+
+			volume := datastructures.Find(podSpec.Volumes, func(v corev1.Volume) bool {
+				return v.Name == batchStorageVolume
+			})
+			if volume == nil {
+				t.Fatalf("expected staging Volume \"%s\" to exist", batchStorageVolume)
+			}
+			if !reflect.DeepEqual(tt.wantVolume, volume.VolumeSource) {
+				t.Errorf("unexpected staging Volume, want: %v got: %v", tt.wantVolume, volume.VolumeSource)
+			}
+
+			containers := append(podSpec.InitContainers, podSpec.Containers...)
+			for _, container := range containers {
+				volumeMount := datastructures.Find(container.VolumeMounts, func(vm corev1.VolumeMount) bool {
+					return vm.Name == batchStorageVolume
+				})
+				if volumeMount == nil || volumeMount.MountPath != batchStorageMountPath {
+					t.Errorf("expected container \"%s\" to mount the staging Volume in \"%s\"", container.Name, batchStorageMountPath)
+				}
+			}
+		})
+	}
+}
+
 func TestRestoreJobMeta(t *testing.T) {
 	builder := newDefaultTestBuilder(t)
 	key := types.NamespacedName{
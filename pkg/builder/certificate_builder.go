@@ -19,6 +19,9 @@ type CertOpts struct {
 	Key                   *types.NamespacedName
 	Owner                 metav1.Object
 	DNSNames              []string
+	Subject               *certmanagerv1.X509Subject
+	IPAddresses           []string
+	URIs                  []string
 	Lifetime              *time.Duration
 	RenewBeforePercentage *int32
 	Usages                []certmanagerv1.KeyUsage
@@ -45,6 +48,31 @@ func WithDNSnames(names []string) CertOpt {
 	}
 }
 
+func WithSubject(organizations, organizationalUnits []string) CertOpt {
+	return func(o *CertOpts) {
+		if len(organizations) == 0 && len(organizationalUnits) == 0 {
+			o.Subject = nil
+			return
+		}
+		o.Subject = &certmanagerv1.X509Subject{
+			Organizations:       organizations,
+			OrganizationalUnits: organizationalUnits,
+		}
+	}
+}
+
+func WithIPAddresses(ips []string) CertOpt {
+	return func(o *CertOpts) {
+		o.IPAddresses = ips
+	}
+}
+
+func WithURIs(uris []string) CertOpt {
+	return func(o *CertOpts) {
+		o.URIs = uris
+	}
+}
+
 func WithLifetime(lifetime time.Duration) CertOpt {
 	return func(o *CertOpts) {
 		o.Lifetime = ptr.To(lifetime)
@@ -100,7 +128,10 @@ func (b *Builder) BuildCertificate(certOpts ...CertOpt) (*certmanagerv1.Certific
 			Duration:    &metav1.Duration{Duration: *opts.Lifetime},
 			RenewBefore: &metav1.Duration{Duration: *renewBefore},
 			DNSNames:    opts.DNSNames,
+			IPAddresses: opts.IPAddresses,
+			URIs:        opts.URIs,
 			CommonName:  opts.DNSNames[0],
+			Subject:     opts.Subject,
 			Usages:      opts.Usages,
 			IssuerRef:   *opts.IssuerRef,
 			IsCA:        false,
@@ -111,7 +142,7 @@ func (b *Builder) BuildCertificate(certOpts ...CertOpt) (*certmanagerv1.Certific
 			},
 			SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
 				Labels: map[string]string{
-					metadata.WatchLabel: "",
+					metadata.WatchLabel: metadata.WatchLabelValue,
 				},
 			},
 			SecretName:           opts.Key.Name,
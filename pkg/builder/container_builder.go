@@ -42,6 +42,10 @@ var (
 		TimeoutSeconds:      5,
 		PeriodSeconds:       10,
 	}
+	// defaultGaleraStartupFailureThreshold grants Galera Pods a startup window of around 5 minutes by default,
+	// as they may need to perform crash recovery or a state transfer before being able to join the cluster.
+	defaultGaleraStartupFailureThreshold int32 = 30
+
 	defaultGaleraAgentProbe = func(galera mariadbv1alpha1.Galera) *corev1.Probe {
 		return &corev1.Probe{
 			ProbeHandler: corev1.ProbeHandler{
@@ -78,6 +82,9 @@ func (b *Builder) mariadbContainers(mariadb *mariadbv1alpha1.MariaDB, opts ...ma
 		mariadbContainer.LivenessProbe = mariadbLivenessProbe(mariadb)
 		mariadbContainer.ReadinessProbe = mariadbReadinessProbe(mariadb)
 	}
+	if mariadbOpts.includeLifecycle && mariadb.Spec.Lifecycle != nil {
+		mariadbContainer.Lifecycle = ptr.To(mariadb.Spec.Lifecycle.ToKubernetesType())
+	}
 
 	if mariadbOpts.command != nil {
 		mariadbContainer.Command = mariadbOpts.command
@@ -150,6 +157,9 @@ func (b *Builder) maxscaleContainers(mxs *mariadbv1alpha1.MaxScale) ([]corev1.Co
 	container.LivenessProbe = maxscaleProbe(mxs, mxs.Spec.LivenessProbe)
 	container.ReadinessProbe = maxscaleProbe(mxs, mxs.Spec.ReadinessProbe)
 	container.StartupProbe = maxscaleProbe(mxs, mxs.Spec.StartupProbe)
+	if tpl.Lifecycle != nil {
+		container.Lifecycle = ptr.To(tpl.Lifecycle.ToKubernetesType())
+	}
 
 	return []corev1.Container{*container}, nil
 }
@@ -221,6 +231,9 @@ func (b *Builder) galeraAgentContainer(mariadb *mariadbv1alpha1.MariaDB) (*corev
 		}
 		return defaultGaleraAgentProbe(galera)
 	}()
+	if agent.Lifecycle != nil {
+		container.Lifecycle = ptr.To(agent.Lifecycle.ToKubernetesType())
+	}
 	return container, nil
 }
 
@@ -605,7 +618,11 @@ func mariadbLivenessProbe(mariadb *mariadbv1alpha1.MariaDB) *corev1.Probe {
 
 func mariadbStartupProbe(mariadb *mariadbv1alpha1.MariaDB) *corev1.Probe {
 	if mariadb.IsGaleraEnabled() {
-		return mariadbGaleraProbe(mariadb, "/liveness", mariadb.Spec.StartupProbe)
+		probe := mariadbGaleraProbe(mariadb, "/liveness", mariadb.Spec.StartupProbe)
+		if probe.FailureThreshold == 0 {
+			probe.FailureThreshold = defaultGaleraStartupFailureThreshold
+		}
+		return probe
 	}
 	return mariadbProbe(mariadb, mariadb.Spec.StartupProbe)
 }
@@ -265,6 +265,7 @@ func TestMariadbStartupProbe(t *testing.T) {
 				InitialDelaySeconds: 20,
 				TimeoutSeconds:      5,
 				PeriodSeconds:       10,
+				FailureThreshold:    30,
 			},
 		},
 		{
@@ -1931,6 +1932,116 @@ func TestMaxscaleContainers(t *testing.T) {
 	}
 }
 
+func TestMariadbContainerLifecycle(t *testing.T) {
+	preStop := &mariadbv1alpha1.LifecycleHandler{
+		Exec: &mariadbv1alpha1.ExecAction{
+			Command: []string{
+				"bash",
+				"-c",
+				"mariadb-admin -u root -p\"${MARIADB_ROOT_PASSWORD}\" shutdown",
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		mariadb       *mariadbv1alpha1.MariaDB
+		opts          []mariadbPodOpt
+		wantLifecycle *corev1.Lifecycle
+	}{
+		{
+			name:          "No lifecycle",
+			mariadb:       &mariadbv1alpha1.MariaDB{},
+			wantLifecycle: nil,
+		},
+		{
+			name: "PreStop exec",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					ContainerTemplate: mariadbv1alpha1.ContainerTemplate{
+						Lifecycle: &mariadbv1alpha1.Lifecycle{
+							PreStop: preStop,
+						},
+					},
+				},
+			},
+			wantLifecycle: &corev1.Lifecycle{
+				PreStop: &corev1.LifecycleHandler{
+					Exec: &corev1.ExecAction{
+						Command: []string{
+							"bash",
+							"-c",
+							"mariadb-admin -u root -p\"${MARIADB_ROOT_PASSWORD}\" shutdown",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PostStart and PreStop sleep",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					ContainerTemplate: mariadbv1alpha1.ContainerTemplate{
+						Lifecycle: &mariadbv1alpha1.Lifecycle{
+							PostStart: &mariadbv1alpha1.LifecycleHandler{
+								TCPSocket: &mariadbv1alpha1.TCPSocketAction{
+									Port: intstr.FromInt(3306),
+								},
+							},
+							PreStop: &mariadbv1alpha1.LifecycleHandler{
+								Sleep: &mariadbv1alpha1.SleepAction{
+									Seconds: 10,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantLifecycle: &corev1.Lifecycle{
+				PostStart: &corev1.LifecycleHandler{
+					TCPSocket: &corev1.TCPSocketAction{
+						Port: intstr.FromInt(3306),
+					},
+				},
+				PreStop: &corev1.LifecycleHandler{
+					Sleep: &corev1.SleepAction{
+						Seconds: 10,
+					},
+				},
+			},
+		},
+		{
+			name: "Lifecycle disabled",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					ContainerTemplate: mariadbv1alpha1.ContainerTemplate{
+						Lifecycle: &mariadbv1alpha1.Lifecycle{
+							PreStop: preStop,
+						},
+					},
+				},
+			},
+			opts: []mariadbPodOpt{
+				withLifecycle(false),
+			},
+			wantLifecycle: nil,
+		},
+	}
+
+	builder := newDefaultTestBuilder(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			containers, err := builder.mariadbContainers(tt.mariadb, tt.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error building containers: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantLifecycle, containers[0].Lifecycle); diff != "" {
+				t.Errorf("unexpected lifecycle (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func defaultEnv(overrides []corev1.EnvVar) []corev1.EnvVar {
 	mysqlTcpPort := corev1.EnvVar{
 		Name:  "MYSQL_TCP_PORT",
@@ -33,6 +33,7 @@ type mariadbPodOpts struct {
 	includeServiceAccount        bool
 	includePorts                 bool
 	includeProbes                bool
+	includeLifecycle             bool
 	includeHAAnnotations         bool
 	includeAffinity              bool
 }
@@ -46,6 +47,7 @@ func newMariadbPodOpts(userOpts ...mariadbPodOpt) *mariadbPodOpts {
 		includeServiceAccount:        true,
 		includePorts:                 true,
 		includeProbes:                true,
+		includeLifecycle:             true,
 		includeHAAnnotations:         true,
 		includeAffinity:              true,
 	}
@@ -159,6 +161,12 @@ func withProbes(includeProbes bool) mariadbPodOpt {
 	}
 }
 
+func withLifecycle(includeLifecycle bool) mariadbPodOpt {
+	return func(opts *mariadbPodOpts) {
+		opts.includeLifecycle = includeLifecycle
+	}
+}
+
 func withHAAnnotations(includeHAAnnotations bool) mariadbPodOpt {
 	return func(opts *mariadbPodOpts) {
 		opts.includeHAAnnotations = includeHAAnnotations
@@ -291,7 +299,7 @@ func mariadbTopologySpreadConstraints(mariadb *mariadbv1alpha1.MariaDB, opts ...
 	if !mariadbOpts.includeAffinity {
 		return nil
 	}
-	return kadapter.ToKubernetesSlice(mariadb.Spec.TopologySpreadConstraints)
+	return kadapter.ToKubernetesSlice(mariadb.Spec.TopologySpreadConstraintsOrDefault(mariadb.ObjectMeta))
 }
 
 func mariadbServiceAccount(mariadb *mariadbv1alpha1.MariaDB, opts ...mariadbPodOpt) string {
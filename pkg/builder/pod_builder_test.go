@@ -810,6 +810,84 @@ func TestMariadbPodBuilderServiceAccount(t *testing.T) {
 	}
 }
 
+func TestMariadbPodBuilderSecurityContext(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name: "test-mariadb-builder-securitycontext",
+	}
+	tests := []struct {
+		name                   string
+		mariadb                *mariadbv1alpha1.MariaDB
+		wantPodSecurityContext *corev1.PodSecurityContext
+		wantSecurityContext    *corev1.SecurityContext
+	}{
+		{
+			name: "default",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+			},
+			wantPodSecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: ptr.To(true),
+				RunAsUser:    ptr.To(mysqlUser),
+				RunAsGroup:   ptr.To(mysqlGroup),
+				FSGroup:      ptr.To(mysqlGroup),
+			},
+			wantSecurityContext: nil,
+		},
+		{
+			name: "custom",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					ContainerTemplate: mariadbv1alpha1.ContainerTemplate{
+						SecurityContext: &mariadbv1alpha1.SecurityContext{
+							RunAsUser:              ptr.To(int64(1001)),
+							RunAsGroup:             ptr.To(int64(1001)),
+							ReadOnlyRootFilesystem: ptr.To(true),
+						},
+					},
+					PodTemplate: mariadbv1alpha1.PodTemplate{
+						PodSecurityContext: &mariadbv1alpha1.PodSecurityContext{
+							RunAsUser:  ptr.To(int64(1001)),
+							RunAsGroup: ptr.To(int64(1001)),
+							FSGroup:    ptr.To(int64(1001)),
+						},
+					},
+				},
+			},
+			wantPodSecurityContext: &corev1.PodSecurityContext{
+				RunAsUser:  ptr.To(int64(1001)),
+				RunAsGroup: ptr.To(int64(1001)),
+				FSGroup:    ptr.To(int64(1001)),
+			},
+			wantSecurityContext: &corev1.SecurityContext{
+				RunAsUser:              ptr.To(int64(1001)),
+				RunAsGroup:             ptr.To(int64(1001)),
+				ReadOnlyRootFilesystem: ptr.To(true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podTpl, err := builder.mariadbPodTemplate(tt.mariadb)
+			if err != nil {
+				t.Fatalf("unexpected error building MariaDB Pod template: %v", err)
+			}
+			if len(podTpl.Spec.Containers) == 0 {
+				t.Fatal("expecting to have containers")
+			}
+			if !reflect.DeepEqual(tt.wantPodSecurityContext, podTpl.Spec.SecurityContext) {
+				t.Errorf("unexpected Pod SecurityContext, want: %v got: %v", tt.wantPodSecurityContext, podTpl.Spec.SecurityContext)
+			}
+			container := podTpl.Spec.Containers[0]
+			if !reflect.DeepEqual(tt.wantSecurityContext, container.SecurityContext) {
+				t.Errorf("unexpected container SecurityContext, want: %v got: %v", tt.wantSecurityContext, container.SecurityContext)
+			}
+		})
+	}
+}
+
 func TestMariadbPodBuilderAffinity(t *testing.T) {
 	builder := newDefaultTestBuilder(t)
 	objMeta := metav1.ObjectMeta{
@@ -881,6 +959,63 @@ func TestMariadbPodBuilderAffinity(t *testing.T) {
 			wantTopologySpreadContraints: true,
 			wantNodeAffinity:             false,
 		},
+		{
+			name: "mariadb zone spread",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					PodTemplate: mariadbv1alpha1.PodTemplate{
+						TopologySpreadZoneEnabled: ptr.To(true),
+					},
+					Storage: mariadbv1alpha1.Storage{
+						Size: ptr.To(resource.MustParse("300Mi")),
+					},
+				},
+			},
+			opts:                         nil,
+			wantAffinity:                 false,
+			wantTopologySpreadContraints: true,
+			wantNodeAffinity:             false,
+		},
+		{
+			name: "mariadb hostname spread",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					PodTemplate: mariadbv1alpha1.PodTemplate{
+						TopologySpreadHostnameEnabled: ptr.To(true),
+					},
+					Storage: mariadbv1alpha1.Storage{
+						Size: ptr.To(resource.MustParse("300Mi")),
+					},
+				},
+			},
+			opts:                         nil,
+			wantAffinity:                 false,
+			wantTopologySpreadContraints: true,
+			wantNodeAffinity:             false,
+		},
+		{
+			name: "mariadb hostname spread with affinity",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					PodTemplate: mariadbv1alpha1.PodTemplate{
+						Affinity: &mariadbv1alpha1.AffinityConfig{
+							AntiAffinityEnabled: ptr.To(true),
+						},
+						TopologySpreadHostnameEnabled: ptr.To(true),
+					},
+					Storage: mariadbv1alpha1.Storage{
+						Size: ptr.To(resource.MustParse("300Mi")),
+					},
+				},
+			},
+			opts:                         nil,
+			wantAffinity:                 true,
+			wantTopologySpreadContraints: false,
+			wantNodeAffinity:             false,
+		},
 		{
 			name: "opt affinity",
 			mariadb: &mariadbv1alpha1.MariaDB{
@@ -71,6 +71,9 @@ func (b *Builder) BuildMariadbStatefulSet(mariadb *mariadbv1alpha1.MariaDB, key
 	}
 
 	var mariadbPodOpts []mariadbPodOpt
+	if volumeMounts := mariadbAdditionalVolumeMounts(mariadb); volumeMounts != nil {
+		mariadbPodOpts = append(mariadbPodOpts, withExtraVolumeMounts(volumeMounts))
+	}
 	if podAnnotations != nil {
 		mariadbPodOpts = append(mariadbPodOpts,
 			withMeta(&mariadbv1alpha1.Metadata{
@@ -88,13 +91,15 @@ func (b *Builder) BuildMariadbStatefulSet(mariadb *mariadbv1alpha1.MariaDB, key
 		Spec: appsv1.StatefulSetSpec{
 			ServiceName:         mariadb.InternalServiceKey().Name,
 			Replicas:            &mariadb.Spec.Replicas,
-			PodManagementPolicy: appsv1.ParallelPodManagement,
+			PodManagementPolicy: mariadbPodManagementPolicy(mariadb),
+			MinReadySeconds:     mariadb.Spec.MinReadySeconds,
 			UpdateStrategy:      *updateStrategy,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: selectorLabels,
 			},
-			Template:             *podTemplate,
-			VolumeClaimTemplates: mariadbVolumeClaimTemplates(mariadb),
+			Template:                             *podTemplate,
+			VolumeClaimTemplates:                 mariadbVolumeClaimTemplates(mariadb),
+			PersistentVolumeClaimRetentionPolicy: mariadb.Spec.Storage.PersistentVolumeClaimRetentionPolicy,
 		},
 	}
 	if err := controllerutil.SetControllerReference(mariadb, sts, b.scheme); err != nil {
@@ -160,6 +165,13 @@ func mariadbUpdateStrategy(mdb *mariadbv1alpha1.MariaDB) (*appsv1.StatefulSetUpd
 	}
 }
 
+func mariadbPodManagementPolicy(mdb *mariadbv1alpha1.MariaDB) appsv1.PodManagementPolicyType {
+	if mdb.Spec.PodManagementPolicy == "" {
+		return appsv1.ParallelPodManagement
+	}
+	return mdb.Spec.PodManagementPolicy
+}
+
 func statefulSetUpdateStrategy(strategy *appsv1.StatefulSetUpdateStrategy) appsv1.StatefulSetUpdateStrategy {
 	if strategy != nil {
 		return *strategy
@@ -212,9 +224,37 @@ func mariadbVolumeClaimTemplates(mariadb *mariadbv1alpha1.MariaDB) []corev1.Pers
 			Spec: vctpl.PersistentVolumeClaimSpec.ToKubernetesType(),
 		})
 	}
+
+	for _, additionalVctpl := range mariadb.Spec.Storage.AdditionalVolumeClaimTemplates {
+		meta := ptr.Deref(additionalVctpl.Metadata, mariadbv1alpha1.Metadata{})
+		labels := labels.NewLabelsBuilder().
+			WithLabels(meta.Labels).
+			WithPVCRole(additionalVctpl.Name).
+			Build()
+
+		pvcs = append(pvcs, corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        additionalVctpl.Name,
+				Labels:      labels,
+				Annotations: meta.Annotations,
+			},
+			Spec: additionalVctpl.PersistentVolumeClaimSpec.ToKubernetesType(),
+		})
+	}
 	return pvcs
 }
 
+func mariadbAdditionalVolumeMounts(mariadb *mariadbv1alpha1.MariaDB) []corev1.VolumeMount {
+	var volumeMounts []corev1.VolumeMount
+	for _, vctpl := range mariadb.Spec.Storage.AdditionalVolumeClaimTemplates {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      vctpl.Name,
+			MountPath: vctpl.MountPath,
+		})
+	}
+	return volumeMounts
+}
+
 func maxscaleVolumeClaimTemplates(maxscale *mariadbv1alpha1.MaxScale) []corev1.PersistentVolumeClaim {
 	vctpl := maxscale.Spec.Config.VolumeClaimTemplate
 	meta := ptr.Deref(vctpl.Metadata, mariadbv1alpha1.Metadata{})
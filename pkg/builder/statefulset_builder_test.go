@@ -134,6 +134,133 @@ func TestMaxScaleImagePullSecrets(t *testing.T) {
 	}
 }
 
+func TestMariadbTolerations(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name:      "mariadb-tolerations",
+		Namespace: "test",
+	}
+
+	tests := []struct {
+		name            string
+		mariadb         *mariadbv1alpha1.MariaDB
+		wantTolerations []corev1.Toleration
+	}{
+		{
+			name: "No tolerations",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+						Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+					},
+				},
+			},
+			wantTolerations: nil,
+		},
+		{
+			name: "Tolerations in MariaDB",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					PodTemplate: mariadbv1alpha1.PodTemplate{
+						Tolerations: []corev1.Toleration{
+							{
+								Key:      "k8s.mariadb.com/ha",
+								Operator: corev1.TolerationOpExists,
+								Effect:   corev1.TaintEffectNoSchedule,
+							},
+						},
+					},
+					UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+						Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+					},
+				},
+			},
+			wantTolerations: []corev1.Toleration{
+				{
+					Key:      "k8s.mariadb.com/ha",
+					Operator: corev1.TolerationOpExists,
+					Effect:   corev1.TaintEffectNoSchedule,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sts, err := builder.BuildMariadbStatefulSet(tt.mariadb, client.ObjectKeyFromObject(tt.mariadb), nil)
+			if err != nil {
+				t.Fatalf("unexpected error building StatefulSet: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantTolerations, sts.Spec.Template.Spec.Tolerations) {
+				t.Errorf("unexpected Tolerations, want: %v  got: %v", tt.wantTolerations, sts.Spec.Template.Spec.Tolerations)
+			}
+		})
+	}
+}
+
+func TestMaxScaleTolerations(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name:      "maxscale-tolerations",
+		Namespace: "test",
+	}
+
+	tests := []struct {
+		name            string
+		maxScale        *mariadbv1alpha1.MaxScale
+		wantTolerations []corev1.Toleration
+	}{
+		{
+			name: "No tolerations",
+			maxScale: &mariadbv1alpha1.MaxScale{
+				ObjectMeta: objMeta,
+				Spec:       mariadbv1alpha1.MaxScaleSpec{},
+			},
+			wantTolerations: nil,
+		},
+		{
+			name: "Tolerations in MaxScale",
+			maxScale: &mariadbv1alpha1.MaxScale{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MaxScaleSpec{
+					MaxScalePodTemplate: mariadbv1alpha1.MaxScalePodTemplate{
+						Tolerations: []corev1.Toleration{
+							{
+								Key:      "k8s.mariadb.com/ha",
+								Operator: corev1.TolerationOpEqual,
+								Value:    "maxscale",
+								Effect:   corev1.TaintEffectNoExecute,
+							},
+						},
+					},
+				},
+			},
+			wantTolerations: []corev1.Toleration{
+				{
+					Key:      "k8s.mariadb.com/ha",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "maxscale",
+					Effect:   corev1.TaintEffectNoExecute,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sts, err := builder.BuildMaxscaleStatefulSet(tt.maxScale, client.ObjectKeyFromObject(tt.maxScale), nil)
+			if err != nil {
+				t.Fatalf("unexpected error building StatefulSet: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantTolerations, sts.Spec.Template.Spec.Tolerations) {
+				t.Errorf("unexpected Tolerations, want: %v  got: %v", tt.wantTolerations, sts.Spec.Template.Spec.Tolerations)
+			}
+		})
+	}
+}
+
 func TestMariaDBStatefulSetMeta(t *testing.T) {
 	builder := newDefaultTestBuilder(t)
 	objMeta := metav1.ObjectMeta{
@@ -447,6 +574,239 @@ func TestMariaDBUpdateStrategy(t *testing.T) {
 	}
 }
 
+func TestMariaDBStatefulSetPodManagement(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name: "mariadb-obj",
+	}
+	tests := []struct {
+		name                    string
+		mariadb                 *mariadbv1alpha1.MariaDB
+		wantPodManagementPolicy appsv1.PodManagementPolicyType
+		wantMinReadySeconds     int32
+	}{
+		{
+			name: "defaults",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+						Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+					},
+				},
+			},
+			wantPodManagementPolicy: appsv1.ParallelPodManagement,
+			wantMinReadySeconds:     0,
+		},
+		{
+			name: "ordered ready",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+						Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+					},
+					PodManagementPolicy: appsv1.OrderedReadyPodManagement,
+				},
+			},
+			wantPodManagementPolicy: appsv1.OrderedReadyPodManagement,
+			wantMinReadySeconds:     0,
+		},
+		{
+			name: "min ready seconds",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+						Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+					},
+					PodManagementPolicy: appsv1.ParallelPodManagement,
+					MinReadySeconds:     30,
+				},
+			},
+			wantPodManagementPolicy: appsv1.ParallelPodManagement,
+			wantMinReadySeconds:     30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sts, err := builder.BuildMariadbStatefulSet(tt.mariadb, client.ObjectKeyFromObject(tt.mariadb), nil)
+			if err != nil {
+				t.Fatalf("unexpected error building StatefulSet: %v", err)
+			}
+			if sts.Spec.PodManagementPolicy != tt.wantPodManagementPolicy {
+				t.Errorf("unexpected podManagementPolicy, want: %v got: %v", tt.wantPodManagementPolicy, sts.Spec.PodManagementPolicy)
+			}
+			if sts.Spec.MinReadySeconds != tt.wantMinReadySeconds {
+				t.Errorf("unexpected minReadySeconds, want: %v got: %v", tt.wantMinReadySeconds, sts.Spec.MinReadySeconds)
+			}
+		})
+	}
+}
+
+func TestMariaDBStatefulSetPVCRetentionPolicy(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	objMeta := metav1.ObjectMeta{
+		Name: "mariadb-obj",
+	}
+	tests := []struct {
+		name                string
+		retentionPolicy     *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy
+		wantRetentionPolicy *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy
+	}{
+		{
+			name:                "no retention policy",
+			retentionPolicy:     nil,
+			wantRetentionPolicy: nil,
+		},
+		{
+			name: "delete on scale down and deletion",
+			retentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+				WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+				WhenScaled:  appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			},
+			wantRetentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+				WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+				WhenScaled:  appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			},
+		},
+		{
+			name: "retain on scale down",
+			retentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+				WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+				WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+			},
+			wantRetentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+				WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+				WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mariadb := &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+						Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+					},
+					Storage: mariadbv1alpha1.Storage{
+						Size:                                 ptr.To(resource.MustParse("300Mi")),
+						PersistentVolumeClaimRetentionPolicy: tt.retentionPolicy,
+					},
+				},
+			}
+			sts, err := builder.BuildMariadbStatefulSet(mariadb, client.ObjectKeyFromObject(mariadb), nil)
+			if err != nil {
+				t.Fatalf("unexpected error building StatefulSet: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantRetentionPolicy, sts.Spec.PersistentVolumeClaimRetentionPolicy) {
+				t.Errorf("unexpected PVC retention policy, want: %v got: %v",
+					tt.wantRetentionPolicy, sts.Spec.PersistentVolumeClaimRetentionPolicy)
+			}
+		})
+	}
+}
+
+func TestMariaDBStatefulSetAdditionalVolumeClaimTemplates(t *testing.T) {
+	builder := newDefaultTestBuilder(t)
+	mariadb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mariadb-obj",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			UpdateStrategy: mariadbv1alpha1.UpdateStrategy{
+				Type: mariadbv1alpha1.ReplicasFirstPrimaryLastUpdateType,
+			},
+			Storage: mariadbv1alpha1.Storage{
+				VolumeClaimTemplate: &mariadbv1alpha1.VolumeClaimTemplate{
+					PersistentVolumeClaimSpec: mariadbv1alpha1.PersistentVolumeClaimSpec{
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse("1Gi"),
+							},
+						},
+					},
+				},
+				AdditionalVolumeClaimTemplates: []mariadbv1alpha1.AdditionalVolumeClaimTemplate{
+					{
+						Name:      "binlogs",
+						MountPath: "/var/lib/mysql-binlogs",
+						VolumeClaimTemplate: mariadbv1alpha1.VolumeClaimTemplate{
+							PersistentVolumeClaimSpec: mariadbv1alpha1.PersistentVolumeClaimSpec{
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: resource.MustParse("5Gi"),
+									},
+								},
+								StorageClassName: ptr.To("fast"),
+							},
+							Metadata: &mariadbv1alpha1.Metadata{
+								Labels: map[string]string{
+									"k8s.mariadb.com/volume": "binlogs",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	sts, err := builder.BuildMariadbStatefulSet(mariadb, client.ObjectKeyFromObject(mariadb), nil)
+	if err != nil {
+		t.Fatalf("unexpected error building StatefulSet: %v", err)
+	}
+
+	if len(sts.Spec.VolumeClaimTemplates) != 2 {
+		t.Fatalf("unexpected number of volume claim templates, want: %d got: %d", 2, len(sts.Spec.VolumeClaimTemplates))
+	}
+	storagePVC := sts.Spec.VolumeClaimTemplates[0]
+	if storagePVC.Name != StorageVolume {
+		t.Errorf("unexpected first volume claim template name, want: %s got: %s", StorageVolume, storagePVC.Name)
+	}
+	binlogsPVC := sts.Spec.VolumeClaimTemplates[1]
+	if binlogsPVC.Name != "binlogs" {
+		t.Errorf("unexpected second volume claim template name, want: %s got: %s", "binlogs", binlogsPVC.Name)
+	}
+	if size := binlogsPVC.Spec.Resources.Requests[corev1.ResourceStorage]; size.Cmp(resource.MustParse("5Gi")) != 0 {
+		t.Errorf("unexpected second volume claim template size, want: %s got: %s", "5Gi", size.String())
+	}
+	if ptr.Deref(binlogsPVC.Spec.StorageClassName, "") != "fast" {
+		t.Errorf("unexpected second volume claim template storage class, want: %s got: %v", "fast", binlogsPVC.Spec.StorageClassName)
+	}
+	if binlogsPVC.Labels["k8s.mariadb.com/volume"] != "binlogs" {
+		t.Errorf("expected second volume claim template to have template labels, got: %v", binlogsPVC.Labels)
+	}
+
+	var mariadbContainer *corev1.Container
+	for i, c := range sts.Spec.Template.Spec.Containers {
+		if c.Name == MariadbContainerName {
+			mariadbContainer = &sts.Spec.Template.Spec.Containers[i]
+		}
+	}
+	if mariadbContainer == nil {
+		t.Fatal("expected MariaDB container to be present")
+	}
+	wantMounts := map[string]string{
+		StorageVolume: MariadbStorageMountPath,
+		"binlogs":     "/var/lib/mysql-binlogs",
+	}
+	for name, mountPath := range wantMounts {
+		found := false
+		for _, vm := range mariadbContainer.VolumeMounts {
+			if vm.Name == name && vm.MountPath == mountPath {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected MariaDB container to mount volume '%s' at '%s', got: %v", name, mountPath, mariadbContainer.VolumeMounts)
+		}
+	}
+}
+
 func TestMaxScaleStatefulSetMeta(t *testing.T) {
 	builder := newDefaultTestBuilder(t)
 	objMeta := metav1.ObjectMeta{
@@ -227,16 +227,111 @@ func (b *BackupCommand) MariadbRestore(restore *mariadbv1alpha1.Restore,
 			"echo 💾 Restoring backup: %s",
 			b.getTargetFilePath(),
 		),
+	}
+	var filters []string
+	if len(restore.Spec.IgnoreDatabases) > 0 || len(restore.Spec.IgnoreTables) > 0 {
+		cmds = append(cmds,
+			fmt.Sprintf(
+				"echo 💾 Ignoring databases '%s' and tables '%s'",
+				strings.Join(restore.Spec.IgnoreDatabases, " "),
+				strings.Join(restore.Spec.IgnoreTables, " "),
+			),
+			ignoreCheckCommand(b.getTargetFilePath()),
+		)
+		filters = append(filters, ignoreCommand(restore.Spec.IgnoreDatabases, restore.Spec.IgnoreTables))
+	}
+	if restore.Spec.Database != "" && restore.Spec.TargetDatabase != "" {
+		cmds = append(cmds,
+			fmt.Sprintf(
+				"echo 💾 Renaming database '%s' to '%s'",
+				restore.Spec.Database,
+				restore.Spec.TargetDatabase,
+			),
+		)
+		filters = append(filters, renameDatabaseCommand(restore.Spec.Database, restore.Spec.TargetDatabase))
+	}
+	if len(filters) > 0 {
+		pipeline := fmt.Sprintf("%s < %s", filters[0], b.getTargetFilePath())
+		for _, filter := range filters[1:] {
+			pipeline += fmt.Sprintf(" | %s", filter)
+		}
+		cmds = append(cmds,
+			fmt.Sprintf(
+				"%s | mariadb %s %s",
+				pipeline,
+				ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+				args,
+			),
+		)
+		return NewBashCommand(cmds)
+	}
+	cmds = append(cmds,
 		fmt.Sprintf(
 			"mariadb %s %s < %s",
 			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
 			args,
 			b.getTargetFilePath(),
 		),
+	)
+	return NewBashCommand(cmds)
+}
+
+func (b *BackupCommand) MariadbVerifyBackup() *Command {
+	cmds := []string{
+		"set -euo pipefail",
+		fmt.Sprintf(
+			"echo 💾 Verifying backup: %s",
+			b.getTargetFilePath(),
+		),
+		fmt.Sprintf(
+			"if ! tail -n 1 %s | grep -q '^-- Dump completed'; then echo ❌ Backup is incomplete: %s; exit 1; fi",
+			b.getTargetFilePath(),
+			b.getTargetFilePath(),
+		),
+		"echo ✅ Backup verified",
 	}
 	return NewBashCommand(cmds)
 }
 
+// ignoreCommand filters out the sections of a mariadb-dump backup that belong to the ignored databases and tables.
+// Sections are delimited by the comments that mariadb-dump renders before each database, table, view, event and routine.
+// Versioned SET statements are always kept, as they save and restore session variables.
+func ignoreCommand(databases, tables []string) string {
+	return fmt.Sprintf(
+		"awk -v dbs=' %s ' -v tables=' %s ' '"+
+			"function name(s) { sub(/^[^`]*`/, \"\", s); sub(/`.*$/, \"\", s); return s } "+
+			"/^-- Current Database: `/ { db = name($0); table = \"\" } "+
+			"/^-- (Table structure for table|Dumping data for table|Temporary table structure for view|Final view structure for view) `/ "+
+			"{ table = name($0) } "+
+			"/^-- Dumping (events|routines) for database / { table = \"\" } "+
+			"/^\\/\\*![0-9]+ SET / { print; next } "+
+			"(db == \"\" || index(dbs, \" \" db \" \") == 0) && (table == \"\" || index(tables, \" \" db \".\" table \" \") == 0) { print }'",
+		strings.Join(databases, " "),
+		strings.Join(tables, " "),
+	)
+}
+
+// ignoreCheckCommand fails when the backup does not contain the section comments required by ignoreCommand,
+// which are omitted when the backup is taken with --skip-comments or --compact. Otherwise, the whole backup would be restored.
+func ignoreCheckCommand(file string) string {
+	return fmt.Sprintf(
+		"if ! grep -q '^-- Current Database: `' %s; then echo ❌ Unable to ignore databases and tables, "+
+			"backup has no section comments: %s; exit 1; fi",
+		file,
+		file,
+	)
+}
+
+// renameDatabaseCommand rewrites the CREATE DATABASE and USE statements of a mariadb-dump backup, which are always
+// rendered in their own line, so the database gets restored under a different name. Table data is left untouched.
+func renameDatabaseCommand(database, targetDatabase string) string {
+	return fmt.Sprintf(
+		"sed -E -e 's/^USE `%[1]s`;$/USE `%[2]s`;/' -e 's/^(CREATE DATABASE .*)`%[1]s`/\\1`%[2]s`/'",
+		database,
+		targetDatabase,
+	)
+}
+
 func (b *BackupCommand) newBackupFile() string {
 	var fileName string
 	if b.Compression == mariadbv1alpha1.CompressNone {
@@ -307,7 +402,11 @@ func (b *BackupCommand) mariadbArgs(restore *mariadbv1alpha1.Restore, mariadb *m
 	copy(args, b.BackupOpts.DumpOpts)
 
 	if restore.Spec.Database != "" {
-		args = append(args, fmt.Sprintf("--one-database %s", restore.Spec.Database))
+		database := restore.Spec.Database
+		if restore.Spec.TargetDatabase != "" {
+			database = restore.Spec.TargetDatabase
+		}
+		args = append(args, fmt.Sprintf("--one-database %s", database))
 	}
 
 	if mariadb.IsTLSEnabled() {
@@ -1,12 +1,14 @@
 package command
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	builderpki "github.com/mariadb-operator/mariadb-operator/pkg/builder/pki"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -503,6 +505,20 @@ func TestMariadbArgs(t *testing.T) {
 				"--one-database db1",
 			},
 		},
+		{
+			name:      "target database",
+			backupCmd: &BackupCommand{},
+			restore: &mariadbv1alpha1.Restore{
+				Spec: mariadbv1alpha1.RestoreSpec{
+					Database:       "app",
+					TargetDatabase: "app_staging",
+				},
+			},
+			mariadb: &mariadbv1alpha1.MariaDB{},
+			wantArgs: []string{
+				"--one-database app_staging",
+			},
+		},
 		{
 			name:      "TLS",
 			backupCmd: &BackupCommand{},
@@ -536,3 +552,186 @@ func TestMariadbArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestMariadbRestore(t *testing.T) {
+	tests := []struct {
+		name     string
+		restore  *mariadbv1alpha1.Restore
+		wantArgs []string
+	}{
+		{
+			name:    "all databases",
+			restore: &mariadbv1alpha1.Restore{},
+			wantArgs: []string{
+				"set -euo pipefail;" +
+					"echo 💾 Restoring backup: $(cat '/backup/0-backup-target.txt');" +
+					"mariadb --user=${MARIADB_OPERATOR_USER} --password=${MARIADB_OPERATOR_PASSWORD} " +
+					"--host=mariadb.default.svc.cluster.local --port=3306  < $(cat '/backup/0-backup-target.txt')",
+			},
+		},
+		{
+			name: "database",
+			restore: &mariadbv1alpha1.Restore{
+				Spec: mariadbv1alpha1.RestoreSpec{
+					Database: "app",
+				},
+			},
+			wantArgs: []string{
+				"set -euo pipefail;" +
+					"echo 💾 Restoring backup: $(cat '/backup/0-backup-target.txt');" +
+					"mariadb --user=${MARIADB_OPERATOR_USER} --password=${MARIADB_OPERATOR_PASSWORD} " +
+					"--host=mariadb.default.svc.cluster.local --port=3306 --one-database app < $(cat '/backup/0-backup-target.txt')",
+			},
+		},
+		{
+			name: "target database",
+			restore: &mariadbv1alpha1.Restore{
+				Spec: mariadbv1alpha1.RestoreSpec{
+					Database:       "app",
+					TargetDatabase: "app_staging",
+				},
+			},
+			wantArgs: []string{
+				"set -euo pipefail;" +
+					"echo 💾 Restoring backup: $(cat '/backup/0-backup-target.txt');" +
+					"echo 💾 Renaming database 'app' to 'app_staging';" +
+					"sed -E -e 's/^USE `app`;$/USE `app_staging`;/' -e 's/^(CREATE DATABASE .*)`app`/\\1`app_staging`/' " +
+					"< $(cat '/backup/0-backup-target.txt') | " +
+					"mariadb --user=${MARIADB_OPERATOR_USER} --password=${MARIADB_OPERATOR_PASSWORD} " +
+					"--host=mariadb.default.svc.cluster.local --port=3306 --one-database app_staging",
+			},
+		},
+		{
+			name: "ignore databases and tables",
+			restore: &mariadbv1alpha1.Restore{
+				Spec: mariadbv1alpha1.RestoreSpec{
+					IgnoreDatabases: []string{"audit", "metrics"},
+					IgnoreTables:    []string{"app.sessions"},
+				},
+			},
+			wantArgs: []string{
+				"set -euo pipefail;" +
+					"echo 💾 Restoring backup: $(cat '/backup/0-backup-target.txt');" +
+					"echo 💾 Ignoring databases 'audit metrics' and tables 'app.sessions';" +
+					"if ! grep -q '^-- Current Database: `' $(cat '/backup/0-backup-target.txt'); " +
+					"then echo ❌ Unable to ignore databases and tables, backup has no section comments: " +
+					"$(cat '/backup/0-backup-target.txt'); exit 1; fi;" +
+					ignoreCommand([]string{"audit", "metrics"}, []string{"app.sessions"}) +
+					" < $(cat '/backup/0-backup-target.txt') | " +
+					"mariadb --user=${MARIADB_OPERATOR_USER} --password=${MARIADB_OPERATOR_PASSWORD} " +
+					"--host=mariadb.default.svc.cluster.local --port=3306 ",
+			},
+		},
+		{
+			name: "ignore tables and target database",
+			restore: &mariadbv1alpha1.Restore{
+				Spec: mariadbv1alpha1.RestoreSpec{
+					Database:       "app",
+					TargetDatabase: "app_staging",
+					IgnoreTables:   []string{"app.sessions"},
+				},
+			},
+			wantArgs: []string{
+				"set -euo pipefail;" +
+					"echo 💾 Restoring backup: $(cat '/backup/0-backup-target.txt');" +
+					"echo 💾 Ignoring databases '' and tables 'app.sessions';" +
+					"if ! grep -q '^-- Current Database: `' $(cat '/backup/0-backup-target.txt'); " +
+					"then echo ❌ Unable to ignore databases and tables, backup has no section comments: " +
+					"$(cat '/backup/0-backup-target.txt'); exit 1; fi;" +
+					"echo 💾 Renaming database 'app' to 'app_staging';" +
+					ignoreCommand(nil, []string{"app.sessions"}) +
+					" < $(cat '/backup/0-backup-target.txt') | " +
+					"sed -E -e 's/^USE `app`;$/USE `app_staging`;/' -e 's/^(CREATE DATABASE .*)`app`/\\1`app_staging`/' | " +
+					"mariadb --user=${MARIADB_OPERATOR_USER} --password=${MARIADB_OPERATOR_PASSWORD} " +
+					"--host=mariadb.default.svc.cluster.local --port=3306 --one-database app_staging",
+			},
+		},
+	}
+
+	mariadb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Port: 3306,
+		},
+	}
+	backupCmd, err := NewBackupCommand(
+		WithBackup("/backup", "/backup/0-backup-target.txt"),
+		WithBackupUserEnv("MARIADB_OPERATOR_USER"),
+		WithBackupPasswordEnv("MARIADB_OPERATOR_PASSWORD"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating backup command: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := backupCmd.MariadbRestore(tt.restore, mariadb)
+			if diff := cmp.Diff(tt.wantArgs, cmd.Args); diff != "" {
+				t.Errorf("unexpected args (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMariadbVerifyBackup(t *testing.T) {
+	backupCmd, err := NewBackupCommand(
+		WithBackup("/backup", "/backup/0-backup-target.txt"),
+		WithBackupUserEnv("MARIADB_OPERATOR_USER"),
+		WithBackupPasswordEnv("MARIADB_OPERATOR_PASSWORD"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating backup command: %v", err)
+	}
+	wantCmd := &Command{
+		Command: []string{"bash", "-c"},
+		Args: []string{
+			"set -euo pipefail;" +
+				"echo 💾 Verifying backup: $(cat '/backup/0-backup-target.txt');" +
+				"if ! tail -n 1 $(cat '/backup/0-backup-target.txt') | grep -q '^-- Dump completed'; " +
+				"then echo ❌ Backup is incomplete: $(cat '/backup/0-backup-target.txt'); exit 1; fi;" +
+				"echo ✅ Backup verified",
+		},
+	}
+
+	cmd := backupCmd.MariadbVerifyBackup()
+	if diff := cmp.Diff(wantCmd, cmd); diff != "" {
+		t.Errorf("unexpected command (-want +got):\n%s", diff)
+	}
+}
+
+func TestIgnoreCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		databases  []string
+		tables     []string
+		wantPrefix string
+	}{
+		{
+			name:       "databases",
+			databases:  []string{"audit", "metrics"},
+			tables:     nil,
+			wantPrefix: "awk -v dbs=' audit metrics ' -v tables='  ' '",
+		},
+		{
+			name:       "tables",
+			databases:  nil,
+			tables:     []string{"app.sessions", "app.events"},
+			wantPrefix: "awk -v dbs='  ' -v tables=' app.sessions app.events ' '",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := ignoreCommand(tt.databases, tt.tables)
+			if !strings.HasPrefix(cmd, tt.wantPrefix) {
+				t.Errorf("unexpected command prefix, want: %s got: %s", tt.wantPrefix, cmd)
+			}
+			if !strings.HasSuffix(cmd, "'") {
+				t.Errorf("expected awk program to be quoted, got: %s", cmd)
+			}
+		})
+	}
+}
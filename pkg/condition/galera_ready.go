@@ -20,6 +20,24 @@ func SetGaleraNotReady(c Conditioner) {
 	})
 }
 
+func SetGaleraUnsafeBootstrap(c Conditioner) {
+	c.SetCondition(metav1.Condition{
+		Type:    mariadbv1alpha1.ConditionTypeGaleraReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  mariadbv1alpha1.ConditionReasonGaleraUnsafeBootstrap,
+		Message: "Galera bootstrap forced without safe-to-bootstrap check",
+	})
+}
+
+func SetGaleraSplitBrain(c Conditioner) {
+	c.SetCondition(metav1.Condition{
+		Type:    mariadbv1alpha1.ConditionTypeGaleraReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  mariadbv1alpha1.ConditionReasonGaleraSplitBrain,
+		Message: "Multiple Pods are safe to bootstrap, bootstrap aborted",
+	})
+}
+
 func SetGaleraReady(c Conditioner) {
 	c.SetCondition(metav1.Condition{
 		Type:    mariadbv1alpha1.ConditionTypeGaleraReady,
@@ -5,6 +5,8 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -55,6 +57,9 @@ func (r *CertReconciler) buildCertManagerCert(opts *CertReconcilerOpts, logger l
 		builder.WithKey(opts.certSecretKey),
 		builder.WithOwner(opts.relatedObject),
 		builder.WithDNSnames(opts.certDNSNames),
+		builder.WithSubject(opts.certSubjectOrg, opts.certSubjectOU),
+		builder.WithIPAddresses(certManagerIPAddresses(opts.certIPAddresses)),
+		builder.WithURIs(certManagerURIs(opts.certURIs)),
 		builder.WithLifetime(opts.certLifetime),
 		builder.WithUsages(certManagerKeyUsages(opts, logger)...),
 		builder.WithIssuerRef(*opts.certIssuerRef),
@@ -82,7 +87,10 @@ func (r *CertReconciler) reconcileCertManagerDesiredCert(ctx context.Context, op
 	patch := client.MergeFrom(existingCert.DeepCopy())
 	existingCert.Spec.Duration = desiredCert.Spec.Duration
 	existingCert.Spec.DNSNames = desiredCert.Spec.DNSNames
+	existingCert.Spec.IPAddresses = desiredCert.Spec.IPAddresses
+	existingCert.Spec.URIs = desiredCert.Spec.URIs
 	existingCert.Spec.CommonName = desiredCert.Spec.CommonName
+	existingCert.Spec.Subject = desiredCert.Spec.Subject
 	existingCert.Spec.Usages = desiredCert.Spec.Usages
 	existingCert.Spec.IssuerRef = desiredCert.Spec.IssuerRef
 	existingCert.Spec.SecretName = desiredCert.Spec.SecretName
@@ -107,6 +115,28 @@ func (r *CertReconciler) certManagerCertReady(ctx context.Context, opts *CertRec
 	return fmt.Errorf("Certificate '%s' not ready", opts.certSecretKey.Name)
 }
 
+func certManagerIPAddresses(ips []net.IP) []string {
+	if len(ips) == 0 {
+		return nil
+	}
+	addresses := make([]string, len(ips))
+	for i, ip := range ips {
+		addresses[i] = ip.String()
+	}
+	return addresses
+}
+
+func certManagerURIs(uris []*url.URL) []string {
+	if len(uris) == 0 {
+		return nil
+	}
+	urls := make([]string, len(uris))
+	for i, uri := range uris {
+		urls[i] = uri.String()
+	}
+	return urls
+}
+
 func certManagerKeyUsages(opts *CertReconcilerOpts, logger logr.Logger) []certmanagerv1.KeyUsage {
 	var usages []certmanagerv1.KeyUsage
 	if opts.certKeyUsage != 0 {
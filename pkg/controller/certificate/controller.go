@@ -87,6 +87,9 @@ func (r *CertReconciler) Reconcile(ctx context.Context, certOpts ...CertReconcil
 		if err != nil {
 			return nil, fmt.Errorf("error reconciling certificate: %v", err)
 		}
+		if err := r.reconcileCABundleConfigMap(ctx, result.CAKeyPair, opts, logger); err != nil {
+			return nil, fmt.Errorf("error reconciling CA bundle ConfigMap: %v", err)
+		}
 	}
 
 	return result, nil
@@ -242,6 +245,28 @@ func (r *CertReconciler) reconcileKeyPair(ctx context.Context, key types.Namespa
 		return keyPair, nil
 	}
 
+	if len(secret.Data) > 0 {
+		if err := validateSecretType(&secret, secretType); err != nil {
+			if opts.relatedObject == nil || !metav1.IsControlledBy(&secret, opts.relatedObject) {
+				return nil, fmt.Errorf("invalid Secret \"%s\": %v. Delete it to let the operator recreate it, "+
+					"or provide a Secret with the expected type and keys", key.Name, err)
+			}
+			log.FromContext(ctx).Info("Recreating Secret with unexpected type", "secret", key.Name, "err", err)
+
+			if err := r.Delete(ctx, &secret); err != nil {
+				return nil, fmt.Errorf("error deleting Secret: %v", err)
+			}
+			keyPair, err := createKeyPairFn()
+			if err != nil {
+				return nil, err
+			}
+			if err := r.createSecret(ctx, key, secretType, &corev1.Secret{}, keyPair, opts.relatedObject); err != nil {
+				return nil, err
+			}
+			return keyPair, nil
+		}
+	}
+
 	if secret.Data == nil || shouldRenew {
 		keyPair, err := createKeyPairFn()
 		if err != nil {
@@ -270,6 +295,33 @@ func (r *CertReconciler) reconcileKeyPair(ctx context.Context, key types.Namespa
 	return keyPair, nil
 }
 
+// validateSecretType checks that a Secret has a type and keys compatible with the expected kind of keypair.
+func validateSecretType(secret *corev1.Secret, secretType SecretType) error {
+	if secret.Type != "" && secret.Type != corev1.SecretTypeOpaque && secret.Type != corev1.SecretTypeTLS {
+		return fmt.Errorf("unexpected type \"%s\", expected \"%s\" or \"%s\"", secret.Type, corev1.SecretTypeOpaque, corev1.SecretTypeTLS)
+	}
+	if secretType == SecretTypeTLS && secret.Type != "" && secret.Type != corev1.SecretTypeTLS {
+		return fmt.Errorf("unexpected type \"%s\", expected \"%s\"", secret.Type, corev1.SecretTypeTLS)
+	}
+
+	certKey, privateKeyKey := pki.TLSCertKey, pki.TLSKeyKey
+	kind := "TLS"
+	if secretType == SecretTypeCA {
+		certKey, privateKeyKey = pki.CACertKey, pki.CAKeyKey
+		kind = "CA"
+	}
+	var missingKeys []string
+	for _, k := range []string{certKey, privateKeyKey} {
+		if _, ok := secret.Data[k]; !ok {
+			missingKeys = append(missingKeys, k)
+		}
+	}
+	if len(missingKeys) > 0 {
+		return fmt.Errorf("missing keys %v, expected a %s Secret with keys \"%s\" and \"%s\"", missingKeys, kind, certKey, privateKeyKey)
+	}
+	return nil
+}
+
 func (r *CertReconciler) getCAKeyPair(ctx context.Context, opts *CertReconcilerOpts) (*pki.KeyPair, error) {
 	var secret corev1.Secret
 	if err := r.Get(ctx, opts.caSecretKey, &secret); err != nil {
@@ -369,7 +421,7 @@ func (r *CertReconciler) updateSecretMetadata(secret *corev1.Secret, owner metav
 	if secret.Labels == nil {
 		secret.Labels = make(map[string]string)
 	}
-	secret.Labels[metadata.WatchLabel] = ""
+	secret.Labels[metadata.WatchLabel] = metadata.WatchLabelValue
 
 	if owner != nil {
 		if err := controllerutil.SetControllerReference(owner, secret, r.scheme); err != nil {
@@ -379,27 +431,84 @@ func (r *CertReconciler) updateSecretMetadata(secret *corev1.Secret, owner metav
 	return nil
 }
 
+func (r *CertReconciler) reconcileCABundleConfigMap(ctx context.Context, caKeyPair *pki.KeyPair, opts *CertReconcilerOpts,
+	logger logr.Logger) error {
+	if opts.caBundleConfigMapKey == nil {
+		return nil
+	}
+	bundle, err := r.getCABundlePEM(ctx, caKeyPair, opts, logger)
+	if err != nil {
+		return fmt.Errorf("error getting CA bundle: %v", err)
+	}
+	key := *opts.caBundleConfigMapKey
+
+	var configMap corev1.ConfigMap
+	if err := r.Get(ctx, key, &configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting ConfigMap: %v", err)
+		}
+		configMap = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Data: map[string]string{
+				opts.caBundleConfigMapDataKey: string(bundle),
+			},
+		}
+		if opts.relatedObject != nil {
+			if err := controllerutil.SetControllerReference(opts.relatedObject, &configMap, r.scheme); err != nil {
+				return fmt.Errorf("error setting controller reference to ConfigMap: %v", err)
+			}
+		}
+		if err := r.Create(ctx, &configMap); err != nil {
+			return fmt.Errorf("error creating ConfigMap: %v", err)
+		}
+		return nil
+	}
+
+	if configMap.Data[opts.caBundleConfigMapDataKey] == string(bundle) {
+		return nil
+	}
+	logger.V(1).Info("Updating CA bundle ConfigMap", "configmap", key.Name)
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string)
+	}
+	configMap.Data[opts.caBundleConfigMapDataKey] = string(bundle)
+	if err := r.Patch(ctx, &configMap, patch); err != nil {
+		return fmt.Errorf("error patching ConfigMap: %v", err)
+	}
+	return nil
+}
+
 func (r *CertReconciler) getCABundle(ctx context.Context, caKeyPair *pki.KeyPair, opts *CertReconcilerOpts,
 	logger logr.Logger) ([]*x509.Certificate, error) {
+	bundle, err := r.getCABundlePEM(ctx, caKeyPair, opts, logger)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := pki.ParseCertificates(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing bundle certificates: %v", err)
+	}
+	return certs, nil
+}
+
+func (r *CertReconciler) getCABundlePEM(ctx context.Context, caKeyPair *pki.KeyPair, opts *CertReconcilerOpts,
+	logger logr.Logger) ([]byte, error) {
 	if opts.caBundleSecretKey != nil && opts.caBundleNamespace != nil {
 		bundle, err := r.refResolver.SecretKeyRef(ctx, *opts.caBundleSecretKey, *opts.caBundleNamespace)
 		if err == nil {
-			certs, err := pki.ParseCertificates([]byte(bundle))
-			if err != nil {
-				return nil, fmt.Errorf("error parsing bundle certificates: %v", err)
-			}
-			return certs, nil
+			return []byte(bundle), nil
 		} else {
 			logger.V(1).Info("error getting CA bundle", "err", err)
 		}
 	}
 
 	if caKeyPair != nil {
-		caCerts, err := caKeyPair.Certificates()
-		if err != nil {
-			return nil, fmt.Errorf("error getting CA certificates: %v", err)
-		}
-		return caCerts, nil
+		return caKeyPair.CertPEM, nil
 	}
 
 	return nil, errors.New("unable to get CA bundle")
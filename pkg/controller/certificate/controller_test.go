@@ -0,0 +1,336 @@
+package certificate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mariadb-operator/mariadb-operator/pkg/pki"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestReconcileCABundleConfigMap(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding to scheme: %v", err)
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewCertReconciler(client, scheme, record.NewFakeRecorder(10), nil, nil)
+
+	caKey := types.NamespacedName{Name: "mariadb-ca", Namespace: "default"}
+	configMapKey := types.NamespacedName{Name: "mariadb-ca-bundle", Namespace: "default"}
+	certOpts := []CertReconcilerOpt{
+		WithCA(true, caKey),
+		WithCert(true, types.NamespacedName{Name: "mariadb-tls", Namespace: "default"}, []string{"mariadb.default.svc.cluster.local"}),
+		WithCABundleConfigMap(configMapKey, ""),
+	}
+
+	result, err := reconciler.Reconcile(ctx, certOpts...)
+	if err != nil {
+		t.Fatalf("unexpected error reconciling: %v", err)
+	}
+	assertCABundleConfigMap(t, client, configMapKey, pki.CACertKey, result.CAKeyPair.CertPEM)
+
+	renewedCA, err := pki.CreateCA(pki.WithCommonName(caKey.Name))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+	var caSecret corev1.Secret
+	if err := client.Get(ctx, caKey, &caSecret); err != nil {
+		t.Fatalf("unexpected error getting CA Secret: %v", err)
+	}
+	renewedCA.UpdateCASecret(&caSecret)
+	if err := client.Update(ctx, &caSecret); err != nil {
+		t.Fatalf("unexpected error updating CA Secret: %v", err)
+	}
+
+	result, err = reconciler.Reconcile(ctx, certOpts...)
+	if err != nil {
+		t.Fatalf("unexpected error reconciling after CA renewal: %v", err)
+	}
+	if string(result.CAKeyPair.CertPEM) != string(renewedCA.CertPEM) {
+		t.Fatal("expected reconciled CA to be the renewed CA")
+	}
+	assertCABundleConfigMap(t, client, configMapKey, pki.CACertKey, renewedCA.CertPEM)
+}
+
+func TestReconcileCABundleConfigMapDataKey(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding to scheme: %v", err)
+	}
+	configMapKey := types.NamespacedName{Name: "mariadb-ca-bundle", Namespace: "default"}
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      configMapKey.Name,
+				Namespace: configMapKey.Namespace,
+			},
+			Data: map[string]string{
+				"other": "preserved",
+			},
+		}).
+		Build()
+	reconciler := NewCertReconciler(client, scheme, record.NewFakeRecorder(10), nil, nil)
+
+	result, err := reconciler.Reconcile(
+		ctx,
+		WithCA(true, types.NamespacedName{Name: "mariadb-ca", Namespace: "default"}),
+		WithCert(true, types.NamespacedName{Name: "mariadb-tls", Namespace: "default"}, []string{"mariadb.default.svc.cluster.local"}),
+		WithCABundleConfigMap(configMapKey, "bundle.pem"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error reconciling: %v", err)
+	}
+	configMap := assertCABundleConfigMap(t, client, configMapKey, "bundle.pem", result.CAKeyPair.CertPEM)
+	if configMap.Data["other"] != "preserved" {
+		t.Errorf("expected existing ConfigMap data to be preserved, got: %v", configMap.Data)
+	}
+}
+
+func assertCABundleConfigMap(t *testing.T, c ctrlclient.Client, key types.NamespacedName, dataKey string,
+	wantBundle []byte) *corev1.ConfigMap {
+	t.Helper()
+	var configMap corev1.ConfigMap
+	if err := c.Get(context.Background(), key, &configMap); err != nil {
+		t.Fatalf("unexpected error getting ConfigMap: %v", err)
+	}
+	bundle, ok := configMap.Data[dataKey]
+	if !ok {
+		t.Fatalf("expected ConfigMap to have key '%s'", dataKey)
+	}
+	if bundle != string(wantBundle) {
+		t.Errorf("unexpected CA bundle, got: %s, want: %s", bundle, wantBundle)
+	}
+	certs, err := pki.ParseCertificates([]byte(bundle))
+	if err != nil {
+		t.Fatalf("unexpected error parsing CA bundle: %v", err)
+	}
+	for _, cert := range certs {
+		if !cert.IsCA {
+			t.Errorf("expected CA bundle to only contain CA certificates, got: %s", cert.Subject.CommonName)
+		}
+	}
+	return &configMap
+}
+
+func TestValidateSecretType(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     *corev1.Secret
+		secretType SecretType
+		wantErr    bool
+	}{
+		{
+			name: "CA",
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					pki.CACertKey: []byte("cert"),
+					pki.CAKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeCA,
+			wantErr:    false,
+		},
+		{
+			name: "TLS",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					pki.TLSCertKey: []byte("cert"),
+					pki.TLSKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeTLS,
+			wantErr:    false,
+		},
+		{
+			name: "CA with TLS type",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					pki.CACertKey:  []byte("cert"),
+					pki.CAKeyKey:   []byte("key"),
+					pki.TLSCertKey: []byte("cert"),
+					pki.TLSKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeCA,
+			wantErr:    false,
+		},
+		{
+			name: "CA keys in TLS Secret",
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					pki.CACertKey: []byte("cert"),
+					pki.CAKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeTLS,
+			wantErr:    true,
+		},
+		{
+			name: "TLS keys in CA Secret",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					pki.TLSCertKey: []byte("cert"),
+					pki.TLSKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeCA,
+			wantErr:    true,
+		},
+		{
+			name: "missing private key",
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					pki.CACertKey: []byte("cert"),
+				},
+			},
+			secretType: SecretTypeCA,
+			wantErr:    true,
+		},
+		{
+			name: "Opaque TLS Secret",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					pki.TLSCertKey: []byte("cert"),
+					pki.TLSKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeTLS,
+			wantErr:    true,
+		},
+		{
+			name: "unexpected type",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeBasicAuth,
+				Data: map[string][]byte{
+					pki.CACertKey: []byte("cert"),
+					pki.CAKeyKey:  []byte("key"),
+				},
+			},
+			secretType: SecretTypeCA,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSecretType(tt.secret, tt.secretType)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestReconcileKeyPairMismatchedSecret(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding to scheme: %v", err)
+	}
+	owner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+			UID:       "mariadb-uid",
+		},
+	}
+	caKey := types.NamespacedName{Name: "mariadb-ca", Namespace: "default"}
+	tlsKey := types.NamespacedName{Name: "mariadb-tls", Namespace: "default"}
+
+	tests := []struct {
+		name    string
+		owned   bool
+		wantErr bool
+	}{
+		{
+			name:    "user provided",
+			owned:   false,
+			wantErr: true,
+		},
+		{
+			name:    "owned",
+			owned:   true,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a CA Secret created where the TLS Secret is expected
+			mismatchedSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      tlsKey.Name,
+					Namespace: tlsKey.Namespace,
+				},
+				Data: map[string][]byte{
+					pki.CACertKey: []byte("cert"),
+					pki.CAKeyKey:  []byte("key"),
+				},
+			}
+			if tt.owned {
+				if err := controllerutil.SetControllerReference(owner, mismatchedSecret, scheme); err != nil {
+					t.Fatalf("unexpected error setting controller reference: %v", err)
+				}
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mismatchedSecret).Build()
+			reconciler := NewCertReconciler(client, scheme, record.NewFakeRecorder(10), nil, nil)
+
+			result, err := reconciler.Reconcile(
+				ctx,
+				WithCA(true, caKey),
+				WithCert(true, tlsKey, []string{"mariadb.default.svc.cluster.local"}),
+				WithRelatedObject(owner),
+			)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), tlsKey.Name) {
+					t.Errorf("expected error to reference Secret \"%s\", got: %v", tlsKey.Name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var secret corev1.Secret
+			if err := client.Get(ctx, tlsKey, &secret); err != nil {
+				t.Fatalf("unexpected error getting Secret: %v", err)
+			}
+			if secret.Type != corev1.SecretTypeTLS {
+				t.Errorf("unexpected Secret type, got: %s, want: %s", secret.Type, corev1.SecretTypeTLS)
+			}
+			if _, ok := secret.Data[pki.CACertKey]; ok {
+				t.Errorf("expected recreated Secret not to have key \"%s\"", pki.CACertKey)
+			}
+			keyPair, err := pki.NewKeyPairFromTLSSecret(&secret)
+			if err != nil {
+				t.Fatalf("unexpected error getting keypair from recreated Secret: %v", err)
+			}
+			if string(keyPair.CertPEM) != string(result.CertKeyPair.CertPEM) {
+				t.Error("expected recreated Secret to contain the reconciled certificate")
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package certificate
 import (
 	"crypto/x509"
 	"errors"
+	"net"
+	"net/url"
 	"time"
 
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -15,6 +17,9 @@ type CertReconcilerOpts struct {
 	caBundleSecretKey *mariadbv1alpha1.SecretKeySelector
 	caBundleNamespace *string
 
+	caBundleConfigMapKey     *types.NamespacedName
+	caBundleConfigMapDataKey string
+
 	shouldIssueCA bool
 	caSecretKey   types.NamespacedName
 	caSecretType  SecretType
@@ -26,7 +31,11 @@ type CertReconcilerOpts struct {
 	certIssuerRef   *cmmeta.ObjectReference
 	certSecretKey   types.NamespacedName
 	certCommonName  string
+	certSubjectOrg  []string
+	certSubjectOU   []string
 	certDNSNames    []string
+	certIPAddresses []net.IP
+	certURIs        []*url.URL
 	certLifetime    time.Duration
 	certKeyUsage    x509.KeyUsage
 	certExtKeyUsage []x509.ExtKeyUsage
@@ -35,6 +44,8 @@ type CertReconcilerOpts struct {
 
 	renewBeforePercentage int32
 
+	clockSkew time.Duration
+
 	relatedObject RelatedObject
 }
 
@@ -47,6 +58,18 @@ func WithCABundle(secretKey mariadbv1alpha1.SecretKeySelector, namespace string)
 	}
 }
 
+// WithCABundleConfigMap exports the CA bundle, as concatenated PEM certificates, into a ConfigMap that clients can mount.
+// The data key defaults to ca.crt when empty.
+func WithCABundleConfigMap(key types.NamespacedName, dataKey string) CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.caBundleConfigMapKey = &key
+		if dataKey == "" {
+			dataKey = pki.CACertKey
+		}
+		o.caBundleConfigMapDataKey = dataKey
+	}
+}
+
 func WithCA(shouldIssue bool, secretKey types.NamespacedName) CertReconcilerOpt {
 	return func(o *CertReconcilerOpts) {
 		o.shouldIssueCA = shouldIssue
@@ -84,6 +107,34 @@ func WithCert(shouldIssue bool, secretKey types.NamespacedName, dnsNames []strin
 	}
 }
 
+// WithSubjectOrganization sets the organizations of the certificate subject, required by some trust policies.
+func WithSubjectOrganization(organization []string) CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.certSubjectOrg = organization
+	}
+}
+
+// WithSubjectOU sets the organizational units of the certificate subject, required by some trust policies.
+func WithSubjectOU(organizationalUnit []string) CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.certSubjectOU = organizationalUnit
+	}
+}
+
+// WithIPAddresses sets the IP address SANs of the certificate, for clients reaching the Pods by IP.
+func WithIPAddresses(ips ...net.IP) CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.certIPAddresses = ips
+	}
+}
+
+// WithURIs sets the URI SANs of the certificate, such as SPIFFE identities requested by service meshes.
+func WithURIs(uris ...*url.URL) CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.certURIs = uris
+	}
+}
+
 func WithCertHandler(certHandler CertHandler) CertReconcilerOpt {
 	return func(o *CertReconcilerOpts) {
 		o.certHandler = certHandler
@@ -127,6 +178,14 @@ func WithClientCertKeyUsage() CertReconcilerOpt {
 	}
 }
 
+// WithServerClientCertKeyUsage sets both server and client extended key usages, for certificates used to serve and dial TLS.
+func WithServerClientCertKeyUsage() CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.certKeyUsage = x509.KeyUsageKeyEncipherment
+		o.certExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	}
+}
+
 func WithSupportedPrivateKeys(privateKeys ...pki.PrivateKey) CertReconcilerOpt {
 	return func(o *CertReconcilerOpts) {
 		o.supportedPrivateKeys = privateKeys
@@ -139,6 +198,14 @@ func WithRenewBeforePercentage(percentage int32) CertReconcilerOpt {
 	}
 }
 
+// WithClockSkew sets the time the NotBefore of the issued certificates is backdated by,
+// so they are valid right away in peers with a slightly delayed clock.
+func WithClockSkew(skew time.Duration) CertReconcilerOpt {
+	return func(o *CertReconcilerOpts) {
+		o.clockSkew = skew
+	}
+}
+
 func WithRelatedObject(obj RelatedObject) CertReconcilerOpt {
 	return func(o *CertReconcilerOpts) {
 		o.relatedObject = obj
@@ -149,10 +216,13 @@ func (o *CertReconcilerOpts) CAx509Opts() ([]pki.X509Opt, error) {
 	if o.caCommonName == "" || o.caLifetime == 0 {
 		return nil, errors.New("caCommonName and caValidity must be set")
 	}
+	if o.clockSkew < 0 {
+		return nil, errors.New("clockSkew must not be negative")
+	}
 
 	return []pki.X509Opt{
 		pki.WithCommonName(o.caCommonName),
-		pki.WithNotBefore(time.Now().Add(-1 * time.Hour)),
+		pki.WithNotBefore(time.Now().Add(-o.clockSkew)),
 		pki.WithNotAfter(time.Now().Add(o.caLifetime)),
 		pki.WithKeyPairOpts(o.KeyPairOpts()...),
 	}, nil
@@ -162,11 +232,18 @@ func (o *CertReconcilerOpts) Certx509Opts() ([]pki.X509Opt, error) {
 	if len(o.certDNSNames) == 0 || o.certLifetime == 0 {
 		return nil, errors.New("certDNSNames and certLifetime must be set")
 	}
+	if o.clockSkew < 0 {
+		return nil, errors.New("clockSkew must not be negative")
+	}
 
 	return []pki.X509Opt{
 		pki.WithCommonName(o.certCommonName),
+		pki.WithOrganization(o.certSubjectOrg...),
+		pki.WithOrganizationalUnit(o.certSubjectOU...),
 		pki.WithDNSNames(o.certDNSNames...),
-		pki.WithNotBefore(time.Now().Add(-1 * time.Hour)),
+		pki.WithIPAddresses(o.certIPAddresses...),
+		pki.WithURIs(o.certURIs...),
+		pki.WithNotBefore(time.Now().Add(-o.clockSkew)),
 		pki.WithNotAfter(time.Now().Add(o.certLifetime)),
 		pki.WithKeyUsage(o.certKeyUsage),
 		pki.WithExtKeyUsage(o.certExtKeyUsage...),
@@ -192,6 +269,7 @@ func NewDefaultCertificateOpts() *CertReconcilerOpts {
 			pki.PrivateKeyTypeECDSA,
 		},
 		renewBeforePercentage: pki.DefaultRenewBeforePercentage,
+		clockSkew:             pki.DefaultClockSkew,
 	}
 	return opts
 }
@@ -0,0 +1,238 @@
+package certificate
+
+import (
+	"crypto/x509"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mariadb-operator/mariadb-operator/pkg/pki"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCertExtKeyUsage(t *testing.T) {
+	tests := []struct {
+		name             string
+		opts             []CertReconcilerOpt
+		wantKeyUsage     x509.KeyUsage
+		wantExtKeyUsages []x509.ExtKeyUsage
+	}{
+		{
+			name:             "server",
+			opts:             []CertReconcilerOpt{WithServerCertKeyUsage()},
+			wantKeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement | x509.KeyUsageKeyEncipherment,
+			wantExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		},
+		{
+			name:             "client",
+			opts:             []CertReconcilerOpt{WithClientCertKeyUsage()},
+			wantKeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
+			wantExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+		{
+			name:             "server and client",
+			opts:             []CertReconcilerOpt{WithServerClientCertKeyUsage()},
+			wantKeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement | x509.KeyUsageKeyEncipherment,
+			wantExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		},
+	}
+
+	caKeyPair, err := pki.CreateCA(pki.WithCommonName("mariadb-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leafCert := issueLeafCert(t, caKeyPair, tt.opts...)
+
+			if leafCert.KeyUsage != tt.wantKeyUsage {
+				t.Errorf("unexpected key usage: got %v, want %v", leafCert.KeyUsage, tt.wantKeyUsage)
+			}
+			if !reflect.DeepEqual(leafCert.ExtKeyUsage, tt.wantExtKeyUsages) {
+				t.Errorf("unexpected ext key usages: got %v, want %v", leafCert.ExtKeyUsage, tt.wantExtKeyUsages)
+			}
+		})
+	}
+}
+
+func TestCertSANs(t *testing.T) {
+	caKeyPair, err := pki.CreateCA(pki.WithCommonName("mariadb-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+	spiffeID, err := url.Parse("spiffe://cluster.local/ns/default/sa/mariadb")
+	if err != nil {
+		t.Fatalf("unexpected error parsing URI: %v", err)
+	}
+
+	leafCert := issueLeafCert(
+		t,
+		caKeyPair,
+		WithIPAddresses(net.ParseIP("10.244.0.12"), net.ParseIP("::1")),
+		WithURIs(spiffeID),
+	)
+
+	if !reflect.DeepEqual(leafCert.DNSNames, []string{"mariadb.default.svc.cluster.local"}) {
+		t.Errorf("unexpected DNS names: got %v", leafCert.DNSNames)
+	}
+	wantIPs := []net.IP{net.ParseIP("10.244.0.12"), net.ParseIP("::1")}
+	if len(leafCert.IPAddresses) != len(wantIPs) {
+		t.Fatalf("unexpected number of IP addresses: got %d, want %d", len(leafCert.IPAddresses), len(wantIPs))
+	}
+	for i, ip := range leafCert.IPAddresses {
+		if !ip.Equal(wantIPs[i]) {
+			t.Errorf("unexpected IP address at index %d: got %v, want %v", i, ip, wantIPs[i])
+		}
+	}
+	if len(leafCert.URIs) != 1 || leafCert.URIs[0].String() != spiffeID.String() {
+		t.Errorf("unexpected URIs: got %v, want %v", leafCert.URIs, []*url.URL{spiffeID})
+	}
+
+	opts := NewDefaultCertificateOpts()
+	WithIPAddresses(net.ParseIP("10.244.0.12"))(opts)
+	WithURIs(spiffeID)(opts)
+	if ips := certManagerIPAddresses(opts.certIPAddresses); !reflect.DeepEqual(ips, []string{"10.244.0.12"}) {
+		t.Errorf("unexpected cert-manager IP addresses: got %v", ips)
+	}
+	if uris := certManagerURIs(opts.certURIs); !reflect.DeepEqual(uris, []string{spiffeID.String()}) {
+		t.Errorf("unexpected cert-manager URIs: got %v", uris)
+	}
+}
+
+func TestCertSubject(t *testing.T) {
+	caKeyPair, err := pki.CreateCA(pki.WithCommonName("mariadb-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		certOpts []CertReconcilerOpt
+		wantOrg  []string
+		wantOU   []string
+	}{
+		{
+			name:     "no subject",
+			certOpts: nil,
+			wantOrg:  nil,
+			wantOU:   nil,
+		},
+		{
+			name: "organization",
+			certOpts: []CertReconcilerOpt{
+				WithSubjectOrganization([]string{"mariadb-operator"}),
+			},
+			wantOrg: []string{"mariadb-operator"},
+			wantOU:  nil,
+		},
+		{
+			name: "organization and organizational units",
+			certOpts: []CertReconcilerOpt{
+				WithSubjectOrganization([]string{"mariadb-operator"}),
+				WithSubjectOU([]string{"database", "platform"}),
+			},
+			wantOrg: []string{"mariadb-operator"},
+			wantOU:  []string{"database", "platform"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leafCert := issueLeafCert(t, caKeyPair, tt.certOpts...)
+
+			if leafCert.Subject.CommonName != "mariadb.default.svc.cluster.local" {
+				t.Errorf("unexpected common name: got %v", leafCert.Subject.CommonName)
+			}
+			if !reflect.DeepEqual(leafCert.Subject.Organization, tt.wantOrg) {
+				t.Errorf("unexpected organization: got %v, want %v", leafCert.Subject.Organization, tt.wantOrg)
+			}
+			if !reflect.DeepEqual(leafCert.Subject.OrganizationalUnit, tt.wantOU) {
+				t.Errorf("unexpected organizational unit: got %v, want %v", leafCert.Subject.OrganizationalUnit, tt.wantOU)
+			}
+		})
+	}
+}
+
+func TestClockSkew(t *testing.T) {
+	caKeyPair, err := pki.CreateCA(pki.WithCommonName("mariadb-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		certOpts []CertReconcilerOpt
+		wantSkew time.Duration
+	}{
+		{
+			name:     "default",
+			certOpts: nil,
+			wantSkew: pki.DefaultClockSkew,
+		},
+		{
+			name: "custom skew",
+			certOpts: []CertReconcilerOpt{
+				WithClockSkew(5 * time.Minute),
+			},
+			wantSkew: 5 * time.Minute,
+		},
+		{
+			name: "no skew",
+			certOpts: []CertReconcilerOpt{
+				WithClockSkew(0),
+			},
+			wantSkew: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now().Truncate(time.Second)
+			leafCert := issueLeafCert(t, caKeyPair, tt.certOpts...)
+			after := time.Now()
+
+			// x509 validity times have a precision of seconds
+			wantNotBefore := before.Add(-tt.wantSkew)
+			if leafCert.NotBefore.Before(wantNotBefore) || leafCert.NotBefore.After(after.Add(-tt.wantSkew)) {
+				t.Errorf("unexpected NotBefore: got %v, want %v", leafCert.NotBefore, wantNotBefore)
+			}
+		})
+	}
+
+	opts := NewDefaultCertificateOpts()
+	WithCert(true, types.NamespacedName{Name: "mariadb-tls"}, []string{"mariadb.default.svc.cluster.local"})(opts)
+	WithCACommonName("mariadb-ca")(opts)
+	WithClockSkew(-1 * time.Minute)(opts)
+	if _, err := opts.Certx509Opts(); err == nil {
+		t.Error("expected error getting certificate x509 opts with negative clock skew")
+	}
+	if _, err := opts.CAx509Opts(); err == nil {
+		t.Error("expected error getting CA x509 opts with negative clock skew")
+	}
+}
+
+func issueLeafCert(t *testing.T, caKeyPair *pki.KeyPair, certOpts ...CertReconcilerOpt) *x509.Certificate {
+	t.Helper()
+	opts := NewDefaultCertificateOpts()
+	WithCert(true, types.NamespacedName{Name: "mariadb-tls"}, []string{"mariadb.default.svc.cluster.local"})(opts)
+	for _, setOpt := range certOpts {
+		setOpt(opts)
+	}
+
+	x509Opts, err := opts.Certx509Opts()
+	if err != nil {
+		t.Fatalf("unexpected error getting x509 opts: %v", err)
+	}
+	certKeyPair, err := pki.CreateCert(caKeyPair, x509Opts...)
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate: %v", err)
+	}
+	leafCert, err := certKeyPair.LeafCertificate()
+	if err != nil {
+		t.Fatalf("unexpected error getting leaf certificate: %v", err)
+	}
+	return leafCert
+}
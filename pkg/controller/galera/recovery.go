@@ -5,20 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"sort"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	labels "github.com/mariadb-operator/mariadb-operator/pkg/builder/labels"
+	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	galeraclient "github.com/mariadb-operator/mariadb-operator/pkg/galera/client"
 	galeraerrors "github.com/mariadb-operator/mariadb-operator/pkg/galera/errors"
 	galerarecovery "github.com/mariadb-operator/mariadb-operator/pkg/galera/recovery"
 	mdbhttp "github.com/mariadb-operator/mariadb-operator/pkg/http"
 	jobpkg "github.com/mariadb-operator/mariadb-operator/pkg/job"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/sql"
 	sqlclientset "github.com/mariadb-operator/mariadb-operator/pkg/sqlset"
 	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
@@ -28,9 +34,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,24 +47,39 @@ import (
 
 func (r *GaleraReconciler) reconcileRecovery(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB,
 	logger logr.Logger) (ctrl.Result, error) {
+	rs := newRecoveryStatus(mariadb)
+
+	if mariadb.IsGaleraRecoveryAborted() {
+		return r.abortRecovery(ctx, mariadb, rs, logger)
+	}
+
 	pods, err := r.getPods(ctx, mariadb)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error getting Pods: %v", err)
 	}
+	pods, excludedPods := filterExcludedPods(pods)
+	for _, pod := range excludedPods {
+		logger.Info("Excluding Pod from recovery", "pod", pod)
+		r.recorder.Eventf(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonGaleraPodExcluded,
+			"Pod '%s' excluded from Galera recovery", pod)
+	}
+	rs.setExcluded(excludedPods...)
+
 	if len(pods) == 0 {
 		logger.Info("No Pods to recover. Requeuing...")
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
-	agentClientSet, err := r.newAgentClientSet(ctx, mariadb, mdbhttp.WithTimeout(5*time.Second))
+	agentClientSet, err := r.newAgentClientSet(ctx, mariadb,
+		mdbhttp.WithTimeout(agentTimeout(mariadb)),
+		mdbhttp.WithRetry(agentRetries, agentRetryInterval),
+	)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error getting agent client: %v", err)
 	}
 	sqlClientSet := sqlclientset.NewClientSet(mariadb, r.refResolver)
 	defer sqlClientSet.Close()
 
-	rs := newRecoveryStatus(mariadb)
-
 	if rs.bootstrapTimeout(mariadb) {
 		logger.Info("Galera cluster bootstrap timed out. Resetting recovery status")
 		r.recorder.Event(mariadb, corev1.EventTypeWarning, mariadbv1alpha1.ReasonGaleraClusterBootstrapTimeout,
@@ -85,6 +108,21 @@ func (r *GaleraReconciler) reconcileRecovery(ctx context.Context, mariadb *maria
 	return ctrl.Result{}, nil
 }
 
+func (r *GaleraReconciler) abortRecovery(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, rs *recoveryStatus,
+	logger logr.Logger) (ctrl.Result, error) {
+	if mariadb.Status.GaleraRecovery != nil {
+		logger.Info("Galera recovery aborted. Resetting recovery status")
+		r.recorder.Eventf(mariadb, corev1.EventTypeWarning, mariadbv1alpha1.ReasonGaleraRecoveryAborted,
+			"Galera recovery aborted via '%s' annotation", metadata.GaleraAbortRecoveryAnnotation)
+
+		if err := r.resetRecovery(ctx, mariadb, rs); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error resetting recovery: %v", err)
+		}
+	}
+	logger.V(1).Info("Galera recovery aborted. Requeuing...")
+	return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+}
+
 func (r *GaleraReconciler) recoverCluster(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, pods []corev1.Pod,
 	rs *recoveryStatus, clientSet *agentClientSet, logger logr.Logger) error {
 	galera := ptr.Deref(mariadb.Spec.Galera, mariadbv1alpha1.Galera{})
@@ -96,7 +134,7 @@ func (r *GaleraReconciler) recoverCluster(ctx context.Context, mariadb *mariadbv
 		if err != nil {
 			return fmt.Errorf("error getting source to forcefully bootstrap: %v", err)
 		}
-		rs.setBootstrapping(src.pod)
+		rs.setBootstrapping(src)
 		return r.patchRecoveryStatus(ctx, mariadb, rs)
 	}
 
@@ -111,13 +149,16 @@ func (r *GaleraReconciler) recoverCluster(ctx context.Context, mariadb *mariadbv
 	if err := stateErr.ErrorOrNil(); err != nil {
 		return fmt.Errorf("error getting state: %v", err)
 	}
+	if err := r.checkSafeToBootstrap(ctx, mariadb, rs, logger); err != nil {
+		return err
+	}
 
 	src, err := rs.bootstrapSource(mariadb, nil, logger)
 	if err != nil {
 		logger.V(1).Info("Error getting bootstrap source", "err", err)
 	}
 	if src != nil {
-		rs.setBootstrapping(src.pod)
+		rs.setBootstrapping(src)
 		return r.patchRecoveryStatus(ctx, mariadb, rs)
 	}
 
@@ -137,7 +178,7 @@ func (r *GaleraReconciler) recoverCluster(ctx context.Context, mariadb *mariadbv
 	if err != nil {
 		return fmt.Errorf("error getting bootstrap source: %v", err)
 	}
-	rs.setBootstrapping(src.pod)
+	rs.setBootstrapping(src)
 	if err := r.patchRecoveryStatus(ctx, mariadb, rs); err != nil {
 		return fmt.Errorf("error patching recovery status: %v", err)
 	}
@@ -149,6 +190,11 @@ func (r *GaleraReconciler) restartPods(ctx context.Context, mariadb *mariadbv1al
 	galera := ptr.Deref(mariadb.Spec.Galera, mariadbv1alpha1.Galera{})
 	recovery := ptr.Deref(galera.Recovery, mariadbv1alpha1.GaleraRecovery{})
 
+	if recovery.ForceClusterBootstrapInPod == nil {
+		if err := r.checkSafeToBootstrap(ctx, mariadb, rs, logger); err != nil {
+			return err
+		}
+	}
 	src, err := rs.bootstrapSource(mariadb, recovery.ForceClusterBootstrapInPod, logger)
 	if err != nil {
 		return fmt.Errorf("error getting source to forcefully bootstrap: %v", err)
@@ -162,29 +208,16 @@ func (r *GaleraReconciler) restartPods(ctx context.Context, mariadb *mariadbv1al
 		Name:      src.pod,
 		Namespace: mariadb.Namespace,
 	}
-	podKeys := []types.NamespacedName{
-		bootstrapPodKey,
-	}
-	for i := 0; i < int(mariadb.Spec.Replicas); i++ {
-		name := statefulset.PodName(mariadb.ObjectMeta, i)
-		if name == bootstrapPodKey.Name {
-			continue
-		}
-		podKeys = append(podKeys, types.NamespacedName{
-			Name:      name,
-			Namespace: mariadb.Namespace,
-		})
-	}
 
-	for _, podKey := range podKeys {
+	for _, podKey := range restartPodKeys(mariadb, src.pod, rs, logger) {
 		syncTimeout := ptr.Deref(recovery.PodSyncTimeout, metav1.Duration{Duration: 5 * time.Minute}).Duration
 		syncCtx, syncCancel := context.WithTimeout(ctx, syncTimeout)
 		defer syncCancel()
 
 		if podKey.Name == bootstrapPodKey.Name {
 			logger.Info("Bootstrapping cluster", "pod", podKey.Name)
-			r.recorder.Eventf(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonGaleraClusterBootstrap,
-				"Bootstrapping Galera cluster in Pod '%s'", podKey.Name)
+			r.recorder.Event(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonGaleraClusterBootstrap,
+				bootstrapEventMessage(src))
 
 			if err := r.enableBootstrapWithSource(syncCtx, mariadbKey, src, agentClientSet, logger); err != nil {
 				return fmt.Errorf("error enabling bootstrap in Pod '%s': %v", podKey.Name, err)
@@ -203,7 +236,7 @@ func (r *GaleraReconciler) restartPods(ctx context.Context, mariadb *mariadbv1al
 			if err := r.pollUntilPodDeleted(ctx, mariadbKey, podKey, logger); err != nil {
 				return fmt.Errorf("error deleting Pod '%s': %v", podKey.Name, err)
 			}
-			if err := r.pollUntilPodSynced(ctx, mariadbKey, podKey, sqlClientSet, logger); err != nil {
+			if err := r.pollUntilPodSynced(ctx, mariadbKey, podKey, sqlClientSet, podSyncInitialDelay(mariadb), logger); err != nil {
 				return fmt.Errorf("error waiting for Pod '%s' to be synced: %v", podKey.Name, err)
 			}
 			return nil
@@ -219,6 +252,95 @@ func (r *GaleraReconciler) restartPods(ctx context.Context, mariadb *mariadbv1al
 	return nil
 }
 
+// restartPodKeys returns the Pods to be restarted, starting by the bootstrap Pod. Excluded Pods are skipped, as they are
+// not expected to become synced and waiting for them would block the recovery.
+func restartPodKeys(mariadb *mariadbv1alpha1.MariaDB, bootstrapPod string, rs *recoveryStatus,
+	logger logr.Logger) []types.NamespacedName {
+	podKeys := []types.NamespacedName{
+		{
+			Name:      bootstrapPod,
+			Namespace: mariadb.Namespace,
+		},
+	}
+	for i := 0; i < int(mariadb.Spec.Replicas); i++ {
+		name := statefulset.PodName(mariadb.ObjectMeta, i)
+		if name == bootstrapPod {
+			continue
+		}
+		if rs.isExcluded(name) {
+			logger.Info("Skipping excluded Pod restart", "pod", name)
+			continue
+		}
+		podKeys = append(podKeys, types.NamespacedName{
+			Name:      name,
+			Namespace: mariadb.Namespace,
+		})
+	}
+	return podKeys
+}
+
+// checkSafeToBootstrap ensures that no more than one Pod is marked as safe to bootstrap.
+// Multiple Pods being safe to bootstrap indicates independent partitions, bootstrapping any of them might lead to a split-brain.
+// The check can be bypassed in emergency situations via the metadata.GaleraForceUnsafeBootstrapAnnotation annotation.
+// It is evaluated once per reconciliation, and the events are only emitted when the GaleraReady condition changes.
+func (r *GaleraReconciler) checkSafeToBootstrap(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, rs *recoveryStatus,
+	logger logr.Logger) error {
+	if rs.isSafeToBootstrapChecked() {
+		return nil
+	}
+	pods := rs.safeToBootstrapPods(mariadb)
+	if len(pods) <= 1 {
+		rs.setSafeToBootstrapChecked()
+		return nil
+	}
+	if mariadb.IsGaleraUnsafeBootstrapForced() {
+		logger.Info("Multiple Pods are safe to bootstrap. Forcing unsafe bootstrap", "pods", pods)
+		if !hasGaleraReadyReason(mariadb, mariadbv1alpha1.ConditionReasonGaleraUnsafeBootstrap) {
+			r.recorder.Eventf(mariadb, corev1.EventTypeWarning, mariadbv1alpha1.ReasonGaleraUnsafeBootstrap,
+				"Multiple Pods are safe to bootstrap: %s. Forcing bootstrap via '%s' annotation, this might lead to a split-brain",
+				strings.Join(pods, ", "), metadata.GaleraForceUnsafeBootstrapAnnotation)
+
+			if err := r.patchStatus(ctx, mariadb, func(status *mariadbv1alpha1.MariaDBStatus) {
+				condition.SetGaleraUnsafeBootstrap(status)
+			}); err != nil {
+				return fmt.Errorf("error patching MariaDB status: %v", err)
+			}
+		}
+		rs.setSafeToBootstrapChecked()
+		return nil
+	}
+	logger.Info("Multiple Pods are safe to bootstrap. Aborting bootstrap", "pods", pods)
+	if !hasGaleraReadyReason(mariadb, mariadbv1alpha1.ConditionReasonGaleraSplitBrain) {
+		r.recorder.Eventf(mariadb, corev1.EventTypeWarning, mariadbv1alpha1.ReasonGaleraSplitBrain,
+			"Multiple Pods are safe to bootstrap: %s. This might indicate a split-brain, aborting bootstrap", strings.Join(pods, ", "))
+
+		if err := r.patchStatus(ctx, mariadb, func(status *mariadbv1alpha1.MariaDBStatus) {
+			condition.SetGaleraSplitBrain(status)
+		}); err != nil {
+			return fmt.Errorf("error patching MariaDB status: %v", err)
+		}
+	}
+	return fmt.Errorf("multiple Pods are safe to bootstrap: %s", strings.Join(pods, ", "))
+}
+
+func hasGaleraReadyReason(mariadb *mariadbv1alpha1.MariaDB, reason string) bool {
+	cond := meta.FindStatusCondition(mariadb.Status.Conditions, mariadbv1alpha1.ConditionTypeGaleraReady)
+	return cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == reason
+}
+
+// bootstrapEventMessage returns the cluster bootstrap event message, including the sequence and UUID that justified choosing the Pod.
+func bootstrapEventMessage(src *bootstrapSource) string {
+	if src.bootstrap == nil {
+		return fmt.Sprintf("Bootstrapping Galera cluster in Pod '%s'", src.pod)
+	}
+	return fmt.Sprintf(
+		"Bootstrapping Galera cluster in Pod '%s' with sequence %d and UUID '%s'",
+		src.pod,
+		src.bootstrap.Seqno,
+		src.bootstrap.UUID,
+	)
+}
+
 func (r *GaleraReconciler) getPods(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) ([]corev1.Pod, error) {
 	list := corev1.PodList{}
 	listOpts := &ctrlclient.ListOptions{
@@ -246,10 +368,39 @@ func (r *GaleraReconciler) getPods(ctx context.Context, mariadb *mariadbv1alpha1
 	return scheduledPods, nil
 }
 
+// filterExcludedPods splits the Pods into the ones that should be recovered and the names of the ones
+// excluded via the metadata.GaleraExcludeAnnotation annotation.
+func filterExcludedPods(pods []corev1.Pod) ([]corev1.Pod, []string) {
+	var included []corev1.Pod
+	var excluded []string
+	for _, pod := range pods {
+		if pod.Annotations[metadata.GaleraExcludeAnnotation] == "true" {
+			excluded = append(excluded, pod.Name)
+			continue
+		}
+		included = append(included, pod)
+	}
+	return included, excluded
+}
+
+// newRecoveryGroup returns an errgroup limited to the recovery concurrency, which defaults to the number of Pods.
+func newRecoveryGroup(mariadb *mariadbv1alpha1.MariaDB, numPods int) *errgroup.Group {
+	galera := ptr.Deref(mariadb.Spec.Galera, mariadbv1alpha1.Galera{})
+	recovery := ptr.Deref(galera.Recovery, mariadbv1alpha1.GaleraRecovery{})
+
+	limit := numPods
+	if recovery.Concurrency != nil && *recovery.Concurrency > 0 && int(*recovery.Concurrency) < limit {
+		limit = int(*recovery.Concurrency)
+	}
+
+	g := new(errgroup.Group)
+	g.SetLimit(limit)
+	return g
+}
+
 func (r *GaleraReconciler) getGaleraState(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, pods []corev1.Pod, rs *recoveryStatus,
 	clientSet *agentClientSet, logger logr.Logger) error {
-	g := new(errgroup.Group)
-	g.SetLimit(len(pods))
+	g := newRecoveryGroup(mariadb, len(pods))
 
 	for _, pod := range pods {
 		if _, ok := rs.state(pod.Name); ok {
@@ -283,10 +434,13 @@ func (r *GaleraReconciler) getGaleraState(ctx context.Context, mariadb *mariadbv
 				}
 				galeraState, err := client.Galera.GetState(ctx)
 				if err != nil {
-					if galeraErr, ok := err.(*galeraerrors.Error); ok && galeraErr.HTTPCode == http.StatusNotFound {
+					reason := galeraStateErrorReason(err)
+					if reason == mariadbv1alpha1.ReasonGaleraStateNotFound {
 						stateLogger.Info("Galera state not found. Skipping Pod...")
+						r.recorder.Eventf(mariadb, corev1.EventTypeWarning, reason, "Galera state not found in Pod '%s'", pod.Name)
 						return nil
 					}
+					r.recorder.Eventf(mariadb, corev1.EventTypeWarning, reason, "Error getting Galera state in Pod '%s': %v", pod.Name, err)
 					return fmt.Errorf("error getting Galera state for Pod '%s': %v", pod.Name, err)
 				}
 
@@ -312,6 +466,27 @@ func (r *GaleraReconciler) getGaleraState(ctx context.Context, mariadb *mariadbv
 	return g.Wait()
 }
 
+// galeraStateErrorReason classifies the errors returned by the agent when fetching the Galera state, allowing to tell apart
+// an agent that is not running, a Pod that is down, a timeout and a missing state file.
+func galeraStateErrorReason(err error) string {
+	var galeraErr *galeraerrors.Error
+	if errors.As(err, &galeraErr) && galeraErr.HTTPCode == http.StatusNotFound {
+		return mariadbv1alpha1.ReasonGaleraStateNotFound
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return mariadbv1alpha1.ReasonGaleraAgentUnreachable
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) || errors.Is(err, syscall.EHOSTUNREACH) {
+		return mariadbv1alpha1.ReasonGaleraPodUnreachable
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return mariadbv1alpha1.ReasonGaleraAgentTimeout
+	}
+	return mariadbv1alpha1.ReasonGaleraStateError
+}
+
 func (r *GaleraReconciler) recoverGaleraState(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, pods []corev1.Pod, rs *recoveryStatus,
 	logger logr.Logger) error {
 	galera := ptr.Deref(mariadb.Spec.Galera, mariadbv1alpha1.Galera{})
@@ -337,8 +512,7 @@ func (r *GaleraReconciler) recoverGaleraState(ctx context.Context, mariadb *mari
 		}
 	}()
 
-	g := new(errgroup.Group)
-	g.SetLimit(len(pods))
+	g := newRecoveryGroup(mariadb, len(pods))
 
 	for _, pod := range pods {
 		if _, ok := rs.recovered(pod.Name); ok {
@@ -566,31 +740,48 @@ func (r *GaleraReconciler) pollUntilPodDeleted(ctx context.Context, mariadbKey,
 }
 
 func (r *GaleraReconciler) pollUntilPodSynced(ctx context.Context, mariadbKey, podKey types.NamespacedName,
-	sqlClientSet *sqlclientset.ClientSet, logger logr.Logger) error {
-	return wait.PollWithMariaDB(ctx, mariadbKey, r.Client, logger, func(ctx context.Context) error {
-		var pod corev1.Pod
-		if err := r.Get(ctx, podKey, &pod); err != nil {
-			return fmt.Errorf("error getting Pod '%s': %v", podKey.Name, err)
-		}
+	sqlClientSet *sqlclientset.ClientSet, initialDelay time.Duration, logger logr.Logger) error {
+	return wait.PollWithMariaDBAndBackoff(ctx, mariadbKey, r.Client, initialDelay, podSyncBackoff(), logger,
+		func(ctx context.Context) error {
+			var pod corev1.Pod
+			if err := r.Get(ctx, podKey, &pod); err != nil {
+				return fmt.Errorf("error getting Pod '%s': %v", podKey.Name, err)
+			}
 
-		podIndex, err := statefulset.PodIndex(podKey.Name)
-		if err != nil {
-			return fmt.Errorf("error getting Pod index: %v", err)
-		}
-		sqlClient, err := sqlClientSet.ClientForIndex(ctx, *podIndex, sql.WithTimeout(5*time.Second))
-		if err != nil {
-			return fmt.Errorf("error getting SQL client: %v", err)
-		}
+			podIndex, err := statefulset.PodIndex(podKey.Name)
+			if err != nil {
+				return fmt.Errorf("error getting Pod index: %v", err)
+			}
+			sqlClient, err := sqlClientSet.ClientForIndex(ctx, *podIndex, sql.WithTimeout(5*time.Second))
+			if err != nil {
+				return fmt.Errorf("error getting SQL client: %v", err)
+			}
 
-		synced, err := galeraclient.IsPodSynced(ctx, sqlClient)
-		if err != nil {
-			return fmt.Errorf("error checking Pod sync: %v", err)
-		}
-		if !synced {
-			return errors.New("Pod not synced")
-		}
-		return nil
-	})
+			synced, err := galeraclient.IsPodSynced(ctx, sqlClient)
+			if err != nil {
+				return fmt.Errorf("error checking Pod sync: %v", err)
+			}
+			if !synced {
+				return errors.New("Pod not synced")
+			}
+			return nil
+		})
+}
+
+// podSyncBackoff returns the backoff used to check whether a Pod has joined the cluster, avoiding hammering Pods that are applying a SST.
+func podSyncBackoff() kwait.Backoff {
+	return kwait.Backoff{
+		Duration: 1 * time.Second,
+		Factor:   2,
+		Steps:    math.MaxInt32,
+		Cap:      30 * time.Second,
+	}
+}
+
+func podSyncInitialDelay(mariadb *mariadbv1alpha1.MariaDB) time.Duration {
+	galera := ptr.Deref(mariadb.Spec.Galera, mariadbv1alpha1.Galera{})
+	recovery := ptr.Deref(galera.Recovery, mariadbv1alpha1.GaleraRecovery{})
+	return ptr.Deref(recovery.PodSyncInitialDelay, metav1.Duration{}).Duration
 }
 
 func (r *GaleraReconciler) getJobLogs(ctx context.Context, key types.NamespacedName) (string, error) {
@@ -625,6 +816,19 @@ func (r *GaleraReconciler) getJobLogs(ctx context.Context, key types.NamespacedN
 	return string(bytes), nil
 }
 
+const (
+	// agentRetries is the number of times a failed request to the agent is retried during the recovery.
+	agentRetries = 3
+	// agentRetryInterval is the time to wait between agent request retries during the recovery.
+	agentRetryInterval = 1 * time.Second
+)
+
+func agentTimeout(mariadb *mariadbv1alpha1.MariaDB) time.Duration {
+	galera := ptr.Deref(mariadb.Spec.Galera, mariadbv1alpha1.Galera{})
+	recovery := ptr.Deref(galera.Recovery, mariadbv1alpha1.GaleraRecovery{})
+	return ptr.Deref(recovery.AgentTimeout, metav1.Duration{Duration: 5 * time.Second}).Duration
+}
+
 func (r *GaleraReconciler) resetRecovery(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, rs *recoveryStatus) error {
 	rs.reset()
 	return r.patchRecoveryStatus(ctx, mariadb, rs)
@@ -17,8 +17,10 @@ import (
 )
 
 type recoveryStatus struct {
-	inner mariadbv1alpha1.GaleraRecoveryStatus
-	mux   *sync.RWMutex
+	inner                  mariadbv1alpha1.GaleraRecoveryStatus
+	excluded               map[string]struct{}
+	safeToBootstrapChecked bool
+	mux                    *sync.RWMutex
 }
 
 type bootstrapSource struct {
@@ -100,6 +102,59 @@ func (rs *recoveryStatus) recovered(pod string) (*recovery.Bootstrap, bool) {
 	return bootstrap, ok
 }
 
+// setExcluded sets the Pods that are not taken into account when looking for a bootstrap source.
+// This is not persisted in the status, it is computed from the Pods in every reconciliation.
+func (rs *recoveryStatus) setExcluded(pods ...string) {
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+
+	rs.excluded = make(map[string]struct{}, len(pods))
+	for _, p := range pods {
+		rs.excluded[p] = struct{}{}
+	}
+}
+
+func (rs *recoveryStatus) isExcluded(pod string) bool {
+	rs.mux.RLock()
+	defer rs.mux.RUnlock()
+
+	_, ok := rs.excluded[pod]
+	return ok
+}
+
+// setSafeToBootstrapChecked records that the safe-to-bootstrap check has passed, so it is evaluated once per reconciliation.
+// This is not persisted in the status, as the recovery status is computed in every reconciliation.
+func (rs *recoveryStatus) setSafeToBootstrapChecked() {
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+
+	rs.safeToBootstrapChecked = true
+}
+
+func (rs *recoveryStatus) isSafeToBootstrapChecked() bool {
+	rs.mux.RLock()
+	defer rs.mux.RUnlock()
+
+	return rs.safeToBootstrapChecked
+}
+
+// safeToBootstrapPods returns the Pods that are marked as safe to bootstrap, ignoring the excluded ones.
+func (rs *recoveryStatus) safeToBootstrapPods(mdb *mariadbv1alpha1.MariaDB) []string {
+	rs.mux.RLock()
+	defer rs.mux.RUnlock()
+
+	var pods []string
+	for _, p := range getPodNames(mdb) {
+		if _, ok := rs.excluded[p]; ok {
+			continue
+		}
+		if state := rs.inner.State[p]; state != nil && state.SafeToBootstrap {
+			pods = append(pods, p)
+		}
+	}
+	return pods
+}
+
 func (rs *recoveryStatus) reset() {
 	rs.mux.Lock()
 	defer rs.mux.Unlock()
@@ -107,14 +162,19 @@ func (rs *recoveryStatus) reset() {
 	rs.inner = mariadbv1alpha1.GaleraRecoveryStatus{}
 }
 
-func (rs *recoveryStatus) setBootstrapping(pod string) {
+func (rs *recoveryStatus) setBootstrapping(src *bootstrapSource) {
 	rs.mux.Lock()
 	defer rs.mux.Unlock()
 
-	rs.inner.Bootstrap = &mariadbv1alpha1.GaleraBootstrapStatus{
+	bootstrap := &mariadbv1alpha1.GaleraBootstrapStatus{
 		Time: ptr.To(metav1.NewTime(time.Now())),
-		Pod:  &pod,
+		Pod:  ptr.To(src.pod),
 	}
+	if src.bootstrap != nil {
+		bootstrap.Seqno = ptr.To(src.bootstrap.Seqno)
+		bootstrap.UUID = ptr.To(src.bootstrap.UUID)
+	}
+	rs.inner.Bootstrap = bootstrap
 }
 
 func (rs *recoveryStatus) isBootstrapping() bool {
@@ -159,6 +219,10 @@ func (rs *recoveryStatus) isComplete(mdb *mariadbv1alpha1.MariaDB, logger logr.L
 		state := rs.inner.State[p]
 		recovered := rs.inner.Recovered[p]
 
+		if _, ok := rs.excluded[p]; ok {
+			numSkippedPods++
+			continue
+		}
 		if state != nil && state.SafeToBootstrap {
 			return true
 		}
@@ -208,6 +272,10 @@ func (rs *recoveryStatus) bootstrapSource(mdb *mariadbv1alpha1.MariaDB, forceBoo
 		state := rs.inner.State[p]
 		recovered := rs.inner.Recovered[p]
 
+		if _, ok := rs.excluded[p]; ok {
+			logger.Info("Skipping excluded Pod while looking for a bootstrap source", "pod", p)
+			continue
+		}
 		if state != nil && state.SafeToBootstrap {
 			return &bootstrapSource{
 				bootstrap: &recovery.Bootstrap{
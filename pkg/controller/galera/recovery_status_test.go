@@ -1,6 +1,7 @@
 package galera
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -1628,3 +1629,379 @@ func TestRecoveryStatusPodsRestarted(t *testing.T) {
 		t.Error("expect recovery status to have Pods restarted")
 	}
 }
+
+func TestRecoveryStatusBootstrapTimeout(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeout     *metav1.Duration
+		bootstrap   *mariadbv1alpha1.GaleraBootstrapStatus
+		wantTimeout bool
+	}{
+		{
+			name:        "not bootstrapping",
+			timeout:     nil,
+			bootstrap:   nil,
+			wantTimeout: false,
+		},
+		{
+			name:    "no bootstrap time",
+			timeout: nil,
+			bootstrap: &mariadbv1alpha1.GaleraBootstrapStatus{
+				Pod: ptr.To("mariadb-galera-0"),
+			},
+			wantTimeout: false,
+		},
+		{
+			name:    "within default timeout",
+			timeout: nil,
+			bootstrap: &mariadbv1alpha1.GaleraBootstrapStatus{
+				Time: &metav1.Time{Time: time.Now().Add(-5 * time.Minute)},
+				Pod:  ptr.To("mariadb-galera-0"),
+			},
+			wantTimeout: false,
+		},
+		{
+			name:    "default timeout exceeded",
+			timeout: nil,
+			bootstrap: &mariadbv1alpha1.GaleraBootstrapStatus{
+				Time: &metav1.Time{Time: time.Now().Add(-11 * time.Minute)},
+				Pod:  ptr.To("mariadb-galera-0"),
+			},
+			wantTimeout: true,
+		},
+		{
+			name:    "within configured timeout",
+			timeout: &metav1.Duration{Duration: time.Hour},
+			bootstrap: &mariadbv1alpha1.GaleraBootstrapStatus{
+				Time: &metav1.Time{Time: time.Now().Add(-30 * time.Minute)},
+				Pod:  ptr.To("mariadb-galera-0"),
+			},
+			wantTimeout: false,
+		},
+		{
+			name:    "configured timeout exceeded",
+			timeout: &metav1.Duration{Duration: time.Minute},
+			bootstrap: &mariadbv1alpha1.GaleraBootstrapStatus{
+				Time: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+				Pod:  ptr.To("mariadb-galera-0"),
+			},
+			wantTimeout: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mdb := &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						Enabled: true,
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled:                 true,
+								ClusterBootstrapTimeout: tt.timeout,
+							},
+						},
+					},
+				},
+				Status: mariadbv1alpha1.MariaDBStatus{
+					GaleraRecovery: &mariadbv1alpha1.GaleraRecoveryStatus{
+						Bootstrap: tt.bootstrap,
+					},
+				},
+			}
+			rs := newRecoveryStatus(mdb)
+			if timeout := rs.bootstrapTimeout(mdb); timeout != tt.wantTimeout {
+				t.Errorf("unexpected bootstrap timeout: expected: %v, got: %v", tt.wantTimeout, timeout)
+			}
+		})
+	}
+}
+
+func TestRecoveryStatusBootstrapSourceExcluded(t *testing.T) {
+	mdb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mariadb-galera",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Replicas: 3,
+		},
+		Status: mariadbv1alpha1.MariaDBStatus{
+			GaleraRecovery: &mariadbv1alpha1.GaleraRecoveryStatus{
+				State: map[string]*recovery.GaleraState{
+					"mariadb-galera-0": {
+						Version:         "2.1",
+						UUID:            "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+						Seqno:           1,
+						SafeToBootstrap: true,
+					},
+				},
+				Recovered: map[string]*recovery.Bootstrap{
+					"mariadb-galera-1": {
+						UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+						Seqno: 2,
+					},
+					"mariadb-galera-2": {
+						UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+						Seqno: 3,
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name                string
+		excluded            []string
+		forceBootstrapInPod *string
+		wantSource          *bootstrapSource
+		wantErr             bool
+	}{
+		{
+			name:     "no excluded Pods",
+			excluded: nil,
+			wantSource: &bootstrapSource{
+				bootstrap: &recovery.Bootstrap{
+					UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+					Seqno: 1,
+				},
+				pod: "mariadb-galera-0",
+			},
+			wantErr: false,
+		},
+		{
+			name:     "safe to bootstrap Pod excluded",
+			excluded: []string{"mariadb-galera-0"},
+			wantSource: &bootstrapSource{
+				bootstrap: &recovery.Bootstrap{
+					UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+					Seqno: 3,
+				},
+				pod: "mariadb-galera-2",
+			},
+			wantErr: false,
+		},
+		{
+			name:     "highest sequence Pod excluded",
+			excluded: []string{"mariadb-galera-0", "mariadb-galera-2"},
+			wantSource: &bootstrapSource{
+				bootstrap: &recovery.Bootstrap{
+					UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+					Seqno: 2,
+				},
+				pod: "mariadb-galera-1",
+			},
+			wantErr: false,
+		},
+		{
+			name:       "all Pods excluded",
+			excluded:   []string{"mariadb-galera-0", "mariadb-galera-1", "mariadb-galera-2"},
+			wantSource: nil,
+			wantErr:    true,
+		},
+		{
+			name:                "force bootstrap in excluded Pod",
+			excluded:            []string{"mariadb-galera-0"},
+			forceBootstrapInPod: ptr.To("mariadb-galera-0"),
+			wantSource: &bootstrapSource{
+				pod: "mariadb-galera-0",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := newRecoveryStatus(mdb)
+			rs.setExcluded(tt.excluded...)
+			for _, p := range tt.excluded {
+				if !rs.isExcluded(p) {
+					t.Errorf("expect Pod '%s' to be excluded", p)
+				}
+			}
+
+			source, err := rs.bootstrapSource(mdb, tt.forceBootstrapInPod, logr.Logger{})
+			if !reflect.DeepEqual(tt.wantSource, source) {
+				t.Errorf("unexpected bootstrapSource value: expected: %v, got: %v", tt.wantSource, source)
+			}
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestRecoveryStatusReset(t *testing.T) {
+	mdb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mariadb-galera",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Replicas: 3,
+		},
+		Status: mariadbv1alpha1.MariaDBStatus{
+			GaleraRecovery: &mariadbv1alpha1.GaleraRecoveryStatus{
+				State: map[string]*recovery.GaleraState{
+					"mariadb-galera-0": {
+						Version:         "2.1",
+						UUID:            "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+						Seqno:           1,
+						SafeToBootstrap: false,
+					},
+				},
+				Recovered: map[string]*recovery.Bootstrap{
+					"mariadb-galera-1": {
+						UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+						Seqno: 2,
+					},
+				},
+				Bootstrap: &mariadbv1alpha1.GaleraBootstrapStatus{
+					Time: ptr.To(metav1.Now()),
+					Pod:  ptr.To("mariadb-galera-1"),
+				},
+				PodsRestarted: ptr.To(false),
+			},
+		},
+	}
+	rs := newRecoveryStatus(mdb)
+	if !rs.isBootstrapping() {
+		t.Fatal("expect recovery status to be bootstrapping before reset")
+	}
+
+	rs.reset()
+
+	if !reflect.ValueOf(rs.galeraRecoveryStatus()).IsZero() {
+		t.Errorf("expect recovery status to be empty after reset, got: %v", rs.galeraRecoveryStatus())
+	}
+	if _, ok := rs.state("mariadb-galera-0"); ok {
+		t.Error("expect state to be empty after reset")
+	}
+	if _, ok := rs.recovered("mariadb-galera-1"); ok {
+		t.Error("expect recovered to be empty after reset")
+	}
+	if rs.isBootstrapping() {
+		t.Error("expect recovery status not to be bootstrapping after reset")
+	}
+}
+
+func TestRecoveryStatusSerialization(t *testing.T) {
+	mdb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mariadb-galera",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Replicas: 2,
+		},
+	}
+	rs := newRecoveryStatus(mdb)
+	rs.setState("mariadb-galera-0", &recovery.GaleraState{
+		Version:         "2.1",
+		UUID:            "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+		Seqno:           -1,
+		SafeToBootstrap: false,
+	})
+	rs.setRecovered("mariadb-galera-0", &recovery.Bootstrap{
+		UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+		Seqno: 350454,
+	})
+	rs.setRecovered("mariadb-galera-1", &recovery.Bootstrap{
+		UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+		Seqno: 350450,
+	})
+	rs.setBootstrapping(&bootstrapSource{
+		bootstrap: &recovery.Bootstrap{
+			UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+			Seqno: 350454,
+		},
+		pod: "mariadb-galera-0",
+	})
+
+	bytes, err := json.Marshal(rs.galeraRecoveryStatus())
+	if err != nil {
+		t.Fatalf("unexpected error marshalling status: %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(bytes, &raw); err != nil {
+		t.Fatalf("unexpected error unmarshalling status: %v", err)
+	}
+
+	wantState := map[string]any{
+		"mariadb-galera-0": map[string]any{
+			"version":         "2.1",
+			"uuid":            "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+			"seqno":           float64(-1),
+			"safeToBootstrap": false,
+		},
+	}
+	if !reflect.DeepEqual(wantState, raw["state"]) {
+		t.Errorf("unexpected serialized state: expected: %v, got: %v", wantState, raw["state"])
+	}
+	wantRecovered := map[string]any{
+		"mariadb-galera-0": map[string]any{
+			"uuid":  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+			"seqno": float64(350454),
+		},
+		"mariadb-galera-1": map[string]any{
+			"uuid":  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+			"seqno": float64(350450),
+		},
+	}
+	if !reflect.DeepEqual(wantRecovered, raw["recovered"]) {
+		t.Errorf("unexpected serialized recovered: expected: %v, got: %v", wantRecovered, raw["recovered"])
+	}
+	bootstrap, ok := raw["bootstrap"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected serialized bootstrap, got: %v", raw["bootstrap"])
+	}
+	if bootstrap["pod"] != "mariadb-galera-0" {
+		t.Errorf("unexpected serialized bootstrap Pod: %v", bootstrap["pod"])
+	}
+	if bootstrap["seqno"] != float64(350454) {
+		t.Errorf("unexpected serialized bootstrap sequence: %v", bootstrap["seqno"])
+	}
+	if bootstrap["uuid"] != "f7f695b6-5000-11ef-8b0d-87e9e0e7b347" {
+		t.Errorf("unexpected serialized bootstrap UUID: %v", bootstrap["uuid"])
+	}
+	if _, ok := bootstrap["time"]; !ok {
+		t.Error("expected serialized bootstrap time")
+	}
+
+	var status mariadbv1alpha1.GaleraRecoveryStatus
+	if err := json.Unmarshal(bytes, &status); err != nil {
+		t.Fatalf("unexpected error unmarshalling status: %v", err)
+	}
+	mdb.Status.GaleraRecovery = &status
+	restored := newRecoveryStatus(mdb)
+	if !reflect.DeepEqual(rs.galeraRecoveryStatus().State, restored.galeraRecoveryStatus().State) {
+		t.Errorf("unexpected restored state: %v", restored.galeraRecoveryStatus().State)
+	}
+	if !reflect.DeepEqual(rs.galeraRecoveryStatus().Recovered, restored.galeraRecoveryStatus().Recovered) {
+		t.Errorf("unexpected restored recovered: %v", restored.galeraRecoveryStatus().Recovered)
+	}
+	source, err := restored.bootstrapSource(mdb, nil, logr.Discard())
+	if err != nil {
+		t.Fatalf("unexpected error getting bootstrap source: %v", err)
+	}
+	if source.pod != "mariadb-galera-0" {
+		t.Errorf("unexpected bootstrap source Pod: %s", source.pod)
+	}
+}
+
+func TestRecoveryStatusSetBootstrappingForced(t *testing.T) {
+	rs := newRecoveryStatus(&mariadbv1alpha1.MariaDB{})
+	rs.setBootstrapping(&bootstrapSource{
+		pod: "mariadb-galera-1",
+	})
+
+	bootstrap := rs.galeraRecoveryStatus().Bootstrap
+	if bootstrap == nil {
+		t.Fatal("expected bootstrap status to be set")
+	}
+	if ptr.Deref(bootstrap.Pod, "") != "mariadb-galera-1" {
+		t.Errorf("unexpected bootstrap Pod: %v", ptr.Deref(bootstrap.Pod, ""))
+	}
+	if bootstrap.Seqno != nil || bootstrap.UUID != nil {
+		t.Errorf("expected no sequence nor UUID in forced bootstrap, got: %v %v", bootstrap.Seqno, bootstrap.UUID)
+	}
+}
@@ -0,0 +1,776 @@
+package galera
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	galeraerrors "github.com/mariadb-operator/mariadb-operator/pkg/galera/errors"
+	"github.com/mariadb-operator/mariadb-operator/pkg/galera/recovery"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAgentTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		mariadb *mariadbv1alpha1.MariaDB
+		want    time.Duration
+	}{
+		{
+			name:    "no Galera",
+			mariadb: &mariadbv1alpha1.MariaDB{},
+			want:    5 * time.Second,
+		},
+		{
+			name: "no recovery",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						Enabled: true,
+					},
+				},
+			},
+			want: 5 * time.Second,
+		},
+		{
+			name: "no agent timeout",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						Enabled: true,
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			want: 5 * time.Second,
+		},
+		{
+			name: "agent timeout",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						Enabled: true,
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled:      true,
+								AgentTimeout: &metav1.Duration{Duration: 1 * time.Minute},
+							},
+						},
+					},
+				},
+			},
+			want: 1 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := agentTimeout(tt.mariadb); got != tt.want {
+				t.Errorf("unexpected agent timeout: expected: %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterExcludedPods(t *testing.T) {
+	pod := func(name string, annotations map[string]string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: annotations,
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		pods         []corev1.Pod
+		wantPods     []string
+		wantExcluded []string
+	}{
+		{
+			name:         "no Pods",
+			pods:         nil,
+			wantPods:     nil,
+			wantExcluded: nil,
+		},
+		{
+			name: "no excluded Pods",
+			pods: []corev1.Pod{
+				pod("mariadb-galera-0", nil),
+				pod("mariadb-galera-1", map[string]string{"k8s.mariadb.com/galera": ""}),
+			},
+			wantPods:     []string{"mariadb-galera-0", "mariadb-galera-1"},
+			wantExcluded: nil,
+		},
+		{
+			name: "excluded Pod",
+			pods: []corev1.Pod{
+				pod("mariadb-galera-0", nil),
+				pod("mariadb-galera-1", map[string]string{"k8s.mariadb.com/galera-exclude": "true"}),
+				pod("mariadb-galera-2", nil),
+			},
+			wantPods:     []string{"mariadb-galera-0", "mariadb-galera-2"},
+			wantExcluded: []string{"mariadb-galera-1"},
+		},
+		{
+			name: "exclusion disabled",
+			pods: []corev1.Pod{
+				pod("mariadb-galera-0", map[string]string{"k8s.mariadb.com/galera-exclude": "false"}),
+			},
+			wantPods:     []string{"mariadb-galera-0"},
+			wantExcluded: nil,
+		},
+		{
+			name: "all Pods excluded",
+			pods: []corev1.Pod{
+				pod("mariadb-galera-0", map[string]string{"k8s.mariadb.com/galera-exclude": "true"}),
+				pod("mariadb-galera-1", map[string]string{"k8s.mariadb.com/galera-exclude": "true"}),
+			},
+			wantPods:     nil,
+			wantExcluded: []string{"mariadb-galera-0", "mariadb-galera-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, excluded := filterExcludedPods(tt.pods)
+			var podNames []string
+			for _, p := range pods {
+				podNames = append(podNames, p.Name)
+			}
+			if !reflect.DeepEqual(tt.wantPods, podNames) {
+				t.Errorf("unexpected Pods: expected: %v, got: %v", tt.wantPods, podNames)
+			}
+			if !reflect.DeepEqual(tt.wantExcluded, excluded) {
+				t.Errorf("unexpected excluded Pods: expected: %v, got: %v", tt.wantExcluded, excluded)
+			}
+		})
+	}
+}
+
+func TestRestartPodKeys(t *testing.T) {
+	mdb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb-galera",
+			Namespace: "default",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Replicas: 3,
+		},
+	}
+	tests := []struct {
+		name         string
+		bootstrapPod string
+		excluded     []string
+		wantPods     []string
+	}{
+		{
+			name:         "no excluded Pods",
+			bootstrapPod: "mariadb-galera-1",
+			excluded:     nil,
+			wantPods:     []string{"mariadb-galera-1", "mariadb-galera-0", "mariadb-galera-2"},
+		},
+		{
+			name:         "excluded Pod",
+			bootstrapPod: "mariadb-galera-1",
+			excluded:     []string{"mariadb-galera-2"},
+			wantPods:     []string{"mariadb-galera-1", "mariadb-galera-0"},
+		},
+		{
+			name:         "all other Pods excluded",
+			bootstrapPod: "mariadb-galera-0",
+			excluded:     []string{"mariadb-galera-1", "mariadb-galera-2"},
+			wantPods:     []string{"mariadb-galera-0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := newRecoveryStatus(mdb)
+			rs.setExcluded(tt.excluded...)
+
+			var pods []string
+			for _, key := range restartPodKeys(mdb, tt.bootstrapPod, rs, logr.Discard()) {
+				if key.Namespace != mdb.Namespace {
+					t.Errorf("unexpected namespace for Pod '%s': %s", key.Name, key.Namespace)
+				}
+				pods = append(pods, key.Name)
+			}
+			if !reflect.DeepEqual(tt.wantPods, pods) {
+				t.Errorf("unexpected Pods: expected: %v, got: %v", tt.wantPods, pods)
+			}
+		})
+	}
+}
+
+func TestNewRecoveryGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		mariadb   *mariadbv1alpha1.MariaDB
+		numPods   int
+		wantLimit int
+	}{
+		{
+			name:      "no recovery",
+			mariadb:   &mariadbv1alpha1.MariaDB{},
+			numPods:   3,
+			wantLimit: 3,
+		},
+		{
+			name: "no concurrency",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			numPods:   5,
+			wantLimit: 5,
+		},
+		{
+			name: "concurrency lower than Pods",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled:     true,
+								Concurrency: ptr.To(int32(2)),
+							},
+						},
+					},
+				},
+			},
+			numPods:   5,
+			wantLimit: 2,
+		},
+		{
+			name: "sequential",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled:     true,
+								Concurrency: ptr.To(int32(1)),
+							},
+						},
+					},
+				},
+			},
+			numPods:   3,
+			wantLimit: 1,
+		},
+		{
+			name: "concurrency higher than Pods",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled:     true,
+								Concurrency: ptr.To(int32(10)),
+							},
+						},
+					},
+				},
+			},
+			numPods:   3,
+			wantLimit: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newRecoveryGroup(tt.mariadb, tt.numPods)
+
+			var (
+				mux     sync.Mutex
+				running int
+				peak    int
+			)
+			for i := 0; i < tt.numPods; i++ {
+				g.Go(func() error {
+					mux.Lock()
+					running++
+					if running > peak {
+						peak = running
+					}
+					mux.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					mux.Lock()
+					running--
+					mux.Unlock()
+					return nil
+				})
+			}
+			if err := g.Wait(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if peak > tt.wantLimit {
+				t.Errorf("expected at most %d concurrent tasks, got: %d", tt.wantLimit, peak)
+			}
+			if peak == 0 {
+				t.Error("expected tasks to be executed")
+			}
+		})
+	}
+}
+
+func TestBootstrapEventMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       *bootstrapSource
+		wantContains []string
+		wantMessage  string
+	}{
+		{
+			name: "recovered sequence",
+			source: &bootstrapSource{
+				bootstrap: &recovery.Bootstrap{
+					UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+					Seqno: 42,
+				},
+				pod: "mariadb-galera-1",
+			},
+			wantContains: []string{"mariadb-galera-1", "42", "f7f695b6-5000-11ef-8b0d-87e9e0e7b347"},
+			wantMessage: "Bootstrapping Galera cluster in Pod 'mariadb-galera-1' with sequence 42 " +
+				"and UUID 'f7f695b6-5000-11ef-8b0d-87e9e0e7b347'",
+		},
+		{
+			name: "negative sequence",
+			source: &bootstrapSource{
+				bootstrap: &recovery.Bootstrap{
+					UUID:  "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+					Seqno: -1,
+				},
+				pod: "mariadb-galera-0",
+			},
+			wantContains: []string{"mariadb-galera-0", "-1"},
+			wantMessage: "Bootstrapping Galera cluster in Pod 'mariadb-galera-0' with sequence -1 " +
+				"and UUID 'f7f695b6-5000-11ef-8b0d-87e9e0e7b347'",
+		},
+		{
+			name: "forced bootstrap",
+			source: &bootstrapSource{
+				pod: "mariadb-galera-2",
+			},
+			wantContains: []string{"mariadb-galera-2"},
+			wantMessage:  "Bootstrapping Galera cluster in Pod 'mariadb-galera-2'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := bootstrapEventMessage(tt.source)
+			for _, s := range tt.wantContains {
+				if !strings.Contains(message, s) {
+					t.Errorf("expected message to contain '%s', got: %s", s, message)
+				}
+			}
+			if message != tt.wantMessage {
+				t.Errorf("unexpected message: expected: %s, got: %s", tt.wantMessage, message)
+			}
+		})
+	}
+}
+
+func TestAbortRecoveryWithoutStatus(t *testing.T) {
+	mdb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mariadb-galera",
+			Annotations: map[string]string{
+				"k8s.mariadb.com/galera-abort-recovery": "true",
+			},
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Replicas: 3,
+		},
+	}
+	if !mdb.IsGaleraRecoveryAborted() {
+		t.Fatal("expect Galera recovery to be aborted")
+	}
+
+	r := &GaleraReconciler{}
+	result, err := r.abortRecovery(context.Background(), mdb, newRecoveryStatus(mdb), logr.Discard())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsZero() {
+		t.Error("expect abort to requeue to stop the reconciliation")
+	}
+}
+
+func TestPodSyncInitialDelay(t *testing.T) {
+	tests := []struct {
+		name      string
+		mariadb   *mariadbv1alpha1.MariaDB
+		wantDelay time.Duration
+	}{
+		{
+			name:      "no recovery",
+			mariadb:   &mariadbv1alpha1.MariaDB{},
+			wantDelay: 0,
+		},
+		{
+			name: "no initial delay",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			wantDelay: 0,
+		},
+		{
+			name: "initial delay",
+			mariadb: &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Galera: &mariadbv1alpha1.Galera{
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							Recovery: &mariadbv1alpha1.GaleraRecovery{
+								Enabled:             true,
+								PodSyncInitialDelay: &metav1.Duration{Duration: 30 * time.Second},
+							},
+						},
+					},
+				},
+			},
+			wantDelay: 30 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if delay := podSyncInitialDelay(tt.mariadb); delay != tt.wantDelay {
+				t.Errorf("unexpected initial delay, want: %v got: %v", tt.wantDelay, delay)
+			}
+		})
+	}
+}
+
+func TestPodSyncBackoff(t *testing.T) {
+	backoff := podSyncBackoff()
+	want := []time.Duration{
+		1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		16 * time.Second,
+		30 * time.Second,
+		30 * time.Second,
+		30 * time.Second,
+	}
+	for i, w := range want {
+		if got := backoff.Step(); got != w {
+			t.Errorf("unexpected backoff step %d, want: %v got: %v", i, w, got)
+		}
+	}
+}
+
+func TestCheckSafeToBootstrap(t *testing.T) {
+	state := func(safeToBootstrap bool) *recovery.GaleraState {
+		return &recovery.GaleraState{
+			Version:         "2.1",
+			UUID:            "f7f695b6-5000-11ef-8b0d-87e9e0e7b347",
+			Seqno:           1,
+			SafeToBootstrap: safeToBootstrap,
+		}
+	}
+	tests := []struct {
+		name                string
+		state               map[string]*recovery.GaleraState
+		excluded            []string
+		forceUnsafe         bool
+		conditionReason     string
+		wantPods            []string
+		wantErr             bool
+		wantEvents          int
+		wantConditionReason string
+	}{
+		{
+			name:       "no state",
+			state:      nil,
+			wantPods:   nil,
+			wantErr:    false,
+			wantEvents: 0,
+		},
+		{
+			name: "no Pods safe to bootstrap",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(false),
+				"mariadb-galera-1": state(false),
+				"mariadb-galera-2": state(false),
+			},
+			wantPods:   nil,
+			wantErr:    false,
+			wantEvents: 0,
+		},
+		{
+			name: "single Pod safe to bootstrap",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(false),
+				"mariadb-galera-1": state(true),
+				"mariadb-galera-2": state(false),
+			},
+			wantPods:   []string{"mariadb-galera-1"},
+			wantErr:    false,
+			wantEvents: 0,
+		},
+		{
+			name: "multiple Pods safe to bootstrap",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(true),
+				"mariadb-galera-1": state(false),
+				"mariadb-galera-2": state(true),
+			},
+			wantPods:            []string{"mariadb-galera-0", "mariadb-galera-2"},
+			wantErr:             true,
+			wantEvents:          1,
+			wantConditionReason: mariadbv1alpha1.ConditionReasonGaleraSplitBrain,
+		},
+		{
+			name: "multiple Pods safe to bootstrap already reported",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(true),
+				"mariadb-galera-1": state(false),
+				"mariadb-galera-2": state(true),
+			},
+			conditionReason:     mariadbv1alpha1.ConditionReasonGaleraSplitBrain,
+			wantPods:            []string{"mariadb-galera-0", "mariadb-galera-2"},
+			wantErr:             true,
+			wantEvents:          0,
+			wantConditionReason: mariadbv1alpha1.ConditionReasonGaleraSplitBrain,
+		},
+		{
+			name: "multiple Pods safe to bootstrap with excluded Pod",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(true),
+				"mariadb-galera-1": state(false),
+				"mariadb-galera-2": state(true),
+			},
+			excluded:   []string{"mariadb-galera-2"},
+			wantPods:   []string{"mariadb-galera-0"},
+			wantErr:    false,
+			wantEvents: 0,
+		},
+		{
+			name: "multiple Pods safe to bootstrap with forced unsafe bootstrap",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(true),
+				"mariadb-galera-1": state(false),
+				"mariadb-galera-2": state(true),
+			},
+			forceUnsafe:         true,
+			wantPods:            []string{"mariadb-galera-0", "mariadb-galera-2"},
+			wantErr:             false,
+			wantEvents:          1,
+			wantConditionReason: mariadbv1alpha1.ConditionReasonGaleraUnsafeBootstrap,
+		},
+		{
+			name: "multiple Pods safe to bootstrap with forced unsafe bootstrap already reported",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(true),
+				"mariadb-galera-1": state(false),
+				"mariadb-galera-2": state(true),
+			},
+			forceUnsafe:         true,
+			conditionReason:     mariadbv1alpha1.ConditionReasonGaleraUnsafeBootstrap,
+			wantPods:            []string{"mariadb-galera-0", "mariadb-galera-2"},
+			wantErr:             false,
+			wantEvents:          0,
+			wantConditionReason: mariadbv1alpha1.ConditionReasonGaleraUnsafeBootstrap,
+		},
+		{
+			name: "single Pod safe to bootstrap with forced unsafe bootstrap",
+			state: map[string]*recovery.GaleraState{
+				"mariadb-galera-0": state(false),
+				"mariadb-galera-1": state(true),
+				"mariadb-galera-2": state(false),
+			},
+			forceUnsafe: true,
+			wantPods:    []string{"mariadb-galera-1"},
+			wantErr:     false,
+			wantEvents:  0,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := mariadbv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding to scheme: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mdb := &mariadbv1alpha1.MariaDB{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mariadb-galera",
+					Namespace: "default",
+				},
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					Replicas: 3,
+				},
+				Status: mariadbv1alpha1.MariaDBStatus{
+					GaleraRecovery: &mariadbv1alpha1.GaleraRecoveryStatus{
+						State: tt.state,
+					},
+				},
+			}
+			if tt.forceUnsafe {
+				mdb.Annotations = map[string]string{
+					metadata.GaleraForceUnsafeBootstrapAnnotation: "true",
+				}
+			}
+			if tt.conditionReason != "" {
+				meta.SetStatusCondition(&mdb.Status.Conditions, metav1.Condition{
+					Type:   mariadbv1alpha1.ConditionTypeGaleraReady,
+					Status: metav1.ConditionFalse,
+					Reason: tt.conditionReason,
+				})
+			}
+			rs := newRecoveryStatus(mdb)
+			rs.setExcluded(tt.excluded...)
+
+			if pods := rs.safeToBootstrapPods(mdb); !reflect.DeepEqual(tt.wantPods, pods) {
+				t.Errorf("unexpected safe to bootstrap Pods: expected: %v, got: %v", tt.wantPods, pods)
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			r := &GaleraReconciler{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(mdb).WithStatusSubresource(mdb).Build(),
+				recorder: recorder,
+			}
+			// The check is evaluated multiple times per reconciliation, the events must not be repeated.
+			for i := 0; i < 2; i++ {
+				err := r.checkSafeToBootstrap(context.Background(), mdb, rs, logr.Discard())
+				if tt.wantErr && err == nil {
+					t.Error("expect error to have occurred, got nil")
+				}
+				if !tt.wantErr && err != nil {
+					t.Errorf("expect error to not have occurred, got: %v", err)
+				}
+			}
+			if len(recorder.Events) != tt.wantEvents {
+				t.Errorf("unexpected number of events: expected: %d, got: %d", tt.wantEvents, len(recorder.Events))
+			}
+
+			var gotMdb mariadbv1alpha1.MariaDB
+			if err := r.Get(context.Background(), ctrlclient.ObjectKeyFromObject(mdb), &gotMdb); err != nil {
+				t.Fatalf("unexpected error getting MariaDB: %v", err)
+			}
+			var reason string
+			cond := meta.FindStatusCondition(gotMdb.Status.Conditions, mariadbv1alpha1.ConditionTypeGaleraReady)
+			if cond != nil && cond.Status == metav1.ConditionFalse {
+				reason = cond.Reason
+			}
+			if reason != tt.wantConditionReason {
+				t.Errorf("unexpected GaleraReady condition reason: expected: %v, got: %v", tt.wantConditionReason, cond)
+			}
+		})
+	}
+}
+
+func TestGaleraStateErrorReason(t *testing.T) {
+	urlErr := func(err error) error {
+		return &url.Error{
+			Op:  "Get",
+			URL: "https://mariadb-galera-0.mariadb-galera-internal.default.svc.cluster.local:5555/api/galera/state",
+			Err: err,
+		}
+	}
+	dialErr := func(err error) error {
+		return urlErr(&net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: err,
+		})
+	}
+	tests := []struct {
+		name       string
+		err        error
+		wantReason string
+	}{
+		{
+			name:       "state not found",
+			err:        galeraerrors.NewError(http.StatusNotFound, "grastate.dat not found"),
+			wantReason: mariadbv1alpha1.ReasonGaleraStateNotFound,
+		},
+		{
+			name:       "internal server error",
+			err:        galeraerrors.NewError(http.StatusInternalServerError, "error reading grastate.dat"),
+			wantReason: mariadbv1alpha1.ReasonGaleraStateError,
+		},
+		{
+			name:       "connection refused",
+			err:        dialErr(os.NewSyscallError("connect", syscall.ECONNREFUSED)),
+			wantReason: mariadbv1alpha1.ReasonGaleraAgentUnreachable,
+		},
+		{
+			name: "no such host",
+			err: dialErr(&net.DNSError{
+				Err:        "no such host",
+				Name:       "mariadb-galera-0.mariadb-galera-internal.default.svc.cluster.local",
+				IsNotFound: true,
+			}),
+			wantReason: mariadbv1alpha1.ReasonGaleraPodUnreachable,
+		},
+		{
+			name:       "host unreachable",
+			err:        dialErr(os.NewSyscallError("connect", syscall.EHOSTUNREACH)),
+			wantReason: mariadbv1alpha1.ReasonGaleraPodUnreachable,
+		},
+		{
+			name:       "context deadline exceeded",
+			err:        urlErr(context.DeadlineExceeded),
+			wantReason: mariadbv1alpha1.ReasonGaleraAgentTimeout,
+		},
+		{
+			name:       "unknown",
+			err:        errors.New("unexpected EOF"),
+			wantReason: mariadbv1alpha1.ReasonGaleraStateError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if reason := galeraStateErrorReason(tt.err); reason != tt.wantReason {
+				t.Errorf("unexpected reason: expected: %s, got: %s", tt.wantReason, reason)
+			}
+		})
+	}
+}
@@ -256,17 +256,17 @@ func (r *ReplicationConfig) reconcileUserSql(ctx context.Context, mariadb *maria
 		return fmt.Errorf("error reconciling replication passsword: %v", err)
 	}
 
-	accountName := formatAccountName(opts.username, opts.host)
+	account := sqlClient.NewAccount(opts.username, opts.host)
 	exists, err := client.UserExists(ctx, opts.username, opts.host)
 	if err != nil {
 		return fmt.Errorf("error checking if replication user exists: %v", err)
 	}
 	if exists {
-		if err := client.AlterUser(ctx, accountName, sqlClient.WithIdentifiedBy(replPassword)); err != nil {
+		if err := client.AlterUser(ctx, account, sqlClient.WithIdentifiedBy(replPassword)); err != nil {
 			return fmt.Errorf("error altering replication user: %v", err)
 		}
 	} else {
-		if err := client.CreateUser(ctx, accountName, sqlClient.WithIdentifiedBy(replPassword)); err != nil {
+		if err := client.CreateUser(ctx, account, sqlClient.WithIdentifiedBy(replPassword)); err != nil {
 			return fmt.Errorf("error creating replication user: %v", err)
 		}
 	}
@@ -275,7 +275,7 @@ func (r *ReplicationConfig) reconcileUserSql(ctx context.Context, mariadb *maria
 		opts.privileges,
 		"*",
 		"*",
-		accountName,
+		account,
 	); err != nil {
 		return fmt.Errorf("error creating grant: %v", err)
 	}
@@ -307,7 +307,3 @@ func binaryFromBool(b *bool) string {
 	}
 	return "0"
 }
-
-func formatAccountName(username, host string) string {
-	return fmt.Sprintf("'%s'@'%s'", username, host)
-}
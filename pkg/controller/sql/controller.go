@@ -9,6 +9,7 @@ import (
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/health"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	sqlClient "github.com/mariadb-operator/mariadb-operator/pkg/sql"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -153,6 +154,16 @@ func (r *SqlReconciler) requeueResult(ctx context.Context, resource Resource, er
 		log.FromContext(ctx).V(1).Info("Error reconciling SQL resource", "err", err)
 		return ctrl.Result{Requeue: true}, nil
 	}
+	interval, err := metadata.ReconcileInterval(resource.GetAnnotations())
+	if err != nil {
+		log.FromContext(ctx).Info("Invalid reconcile interval. Using default", "err", err)
+	}
+	if interval != nil {
+		if r.LogSql {
+			log.FromContext(ctx).V(1).Info("Requeuing SQL resource")
+		}
+		return ctrl.Result{RequeueAfter: *interval}, nil
+	}
 	if resource.RequeueInterval() != nil {
 		if r.LogSql {
 			log.FromContext(ctx).V(1).Info("Requeuing SQL resource")
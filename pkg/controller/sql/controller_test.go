@@ -0,0 +1,118 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestRequeueResult(t *testing.T) {
+	tests := []struct {
+		name            string
+		requeueInterval time.Duration
+		database        *mariadbv1alpha1.Database
+		err             error
+		wantResult      ctrl.Result
+	}{
+		{
+			name:            "error",
+			requeueInterval: 30 * time.Second,
+			database:        &mariadbv1alpha1.Database{},
+			err:             errors.New("test"),
+			wantResult:      ctrl.Result{Requeue: true},
+		},
+		{
+			name:            "no requeue",
+			requeueInterval: 0,
+			database:        &mariadbv1alpha1.Database{},
+			wantResult:      ctrl.Result{},
+		},
+		{
+			name:            "default interval",
+			requeueInterval: 30 * time.Second,
+			database:        &mariadbv1alpha1.Database{},
+			wantResult:      ctrl.Result{RequeueAfter: 30 * time.Second},
+		},
+		{
+			name:            "resource interval",
+			requeueInterval: 30 * time.Second,
+			database: &mariadbv1alpha1.Database{
+				Spec: mariadbv1alpha1.DatabaseSpec{
+					SQLTemplate: mariadbv1alpha1.SQLTemplate{
+						RequeueInterval: &metav1.Duration{Duration: 1 * time.Minute},
+					},
+				},
+			},
+			wantResult: ctrl.Result{RequeueAfter: 1 * time.Minute},
+		},
+		{
+			name:            "annotation interval",
+			requeueInterval: 30 * time.Second,
+			database: &mariadbv1alpha1.Database{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.mariadb.com/reconcile-interval": "10m",
+					},
+				},
+				Spec: mariadbv1alpha1.DatabaseSpec{
+					SQLTemplate: mariadbv1alpha1.SQLTemplate{
+						RequeueInterval: &metav1.Duration{Duration: 1 * time.Minute},
+					},
+				},
+			},
+			wantResult: ctrl.Result{RequeueAfter: 10 * time.Minute},
+		},
+		{
+			name:            "invalid annotation interval",
+			requeueInterval: 30 * time.Second,
+			database: &mariadbv1alpha1.Database{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.mariadb.com/reconcile-interval": "foo",
+					},
+				},
+				Spec: mariadbv1alpha1.DatabaseSpec{
+					SQLTemplate: mariadbv1alpha1.SQLTemplate{
+						RequeueInterval: &metav1.Duration{Duration: 1 * time.Minute},
+					},
+				},
+			},
+			wantResult: ctrl.Result{RequeueAfter: 1 * time.Minute},
+		},
+		{
+			name:            "invalid annotation interval with default",
+			requeueInterval: 30 * time.Second,
+			database: &mariadbv1alpha1.Database{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.mariadb.com/reconcile-interval": "-1m",
+					},
+				},
+			},
+			wantResult: ctrl.Result{RequeueAfter: 30 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler := &SqlReconciler{
+				SqlOptions: SqlOptions{
+					RequeueInterval: tt.requeueInterval,
+				},
+			}
+			result, err := reconciler.requeueResult(context.Background(), tt.database, tt.err)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantResult, result) {
+				t.Errorf("unexpected result, want: %v got: %v", tt.wantResult, result)
+			}
+		})
+	}
+}
@@ -44,12 +44,29 @@ func (r *StatefulSetReconciler) ReconcileWithUpdates(ctx context.Context, desire
 		return nil
 	}
 
+	if err := validatePodManagementPolicy(&existingSts, desiredSts); err != nil {
+		return err
+	}
+
 	if shouldUpdate {
 		patch := client.MergeFrom(existingSts.DeepCopy())
 		existingSts.Spec.Template = desiredSts.Spec.Template
 		existingSts.Spec.UpdateStrategy = desiredSts.Spec.UpdateStrategy
 		existingSts.Spec.Replicas = desiredSts.Spec.Replicas
+		existingSts.Spec.MinReadySeconds = desiredSts.Spec.MinReadySeconds
+		if desiredSts.Spec.PersistentVolumeClaimRetentionPolicy != nil {
+			existingSts.Spec.PersistentVolumeClaimRetentionPolicy = desiredSts.Spec.PersistentVolumeClaimRetentionPolicy
+		}
 		return r.Patch(ctx, &existingSts, patch)
 	}
 	return nil
 }
+
+func validatePodManagementPolicy(existingSts, desiredSts *appsv1.StatefulSet) error {
+	existing := existingSts.Spec.PodManagementPolicy
+	desired := desiredSts.Spec.PodManagementPolicy
+	if existing == "" || desired == "" || existing == desired {
+		return nil
+	}
+	return fmt.Errorf("StatefulSet podManagementPolicy is inmutable: unable to change it from '%s' to '%s'", existing, desired)
+}
@@ -0,0 +1,118 @@
+package statefulset
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileStatefulSet(t *testing.T) {
+	tests := []struct {
+		name                string
+		existingPolicy      appsv1.PodManagementPolicyType
+		desiredPolicy       appsv1.PodManagementPolicyType
+		desiredMinReady     int32
+		wantErr             bool
+		wantMinReadySeconds int32
+	}{
+		{
+			name:                "update min ready seconds",
+			existingPolicy:      appsv1.ParallelPodManagement,
+			desiredPolicy:       appsv1.ParallelPodManagement,
+			desiredMinReady:     30,
+			wantErr:             false,
+			wantMinReadySeconds: 30,
+		},
+		{
+			name:                "change pod management policy",
+			existingPolicy:      appsv1.ParallelPodManagement,
+			desiredPolicy:       appsv1.OrderedReadyPodManagement,
+			desiredMinReady:     30,
+			wantErr:             true,
+			wantMinReadySeconds: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatalf("unexpected error adding to scheme: %v", err)
+			}
+			existingSts := testStatefulSet(tt.existingPolicy, 0)
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existingSts).Build()
+			r := NewStatefulSetReconciler(c)
+
+			err := r.Reconcile(ctx, testStatefulSet(tt.desiredPolicy, tt.desiredMinReady))
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var sts appsv1.StatefulSet
+			if err := c.Get(ctx, client.ObjectKeyFromObject(existingSts), &sts); err != nil {
+				t.Fatalf("unexpected error getting StatefulSet: %v", err)
+			}
+			if sts.Spec.PodManagementPolicy != tt.existingPolicy {
+				t.Errorf("unexpected podManagementPolicy, want: %v got: %v", tt.existingPolicy, sts.Spec.PodManagementPolicy)
+			}
+			if sts.Spec.MinReadySeconds != tt.wantMinReadySeconds {
+				t.Errorf("unexpected minReadySeconds, want: %v got: %v", tt.wantMinReadySeconds, sts.Spec.MinReadySeconds)
+			}
+		})
+	}
+}
+
+func testStatefulSet(policy appsv1.PodManagementPolicyType, minReadySeconds int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:            ptr.To(int32(3)),
+			PodManagementPolicy: policy,
+			MinReadySeconds:     minReadySeconds,
+		},
+	}
+}
+
+func TestReconcileStatefulSetPVCRetentionPolicy(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding to scheme: %v", err)
+	}
+	existingSts := testStatefulSet(appsv1.ParallelPodManagement, 0)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existingSts).Build()
+	r := NewStatefulSetReconciler(c)
+
+	retentionPolicy := &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+	}
+	desiredSts := testStatefulSet(appsv1.ParallelPodManagement, 0)
+	desiredSts.Spec.PersistentVolumeClaimRetentionPolicy = retentionPolicy
+
+	if err := r.Reconcile(ctx, desiredSts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sts appsv1.StatefulSet
+	if err := c.Get(ctx, client.ObjectKeyFromObject(existingSts), &sts); err != nil {
+		t.Fatalf("unexpected error getting StatefulSet: %v", err)
+	}
+	if !reflect.DeepEqual(retentionPolicy, sts.Spec.PersistentVolumeClaimRetentionPolicy) {
+		t.Errorf("unexpected PVC retention policy, want: %v got: %v", retentionPolicy, sts.Spec.PersistentVolumeClaimRetentionPolicy)
+	}
+}
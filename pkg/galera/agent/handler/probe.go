@@ -126,13 +126,13 @@ func (p *Probe) Readiness(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	state, err := sqlClient.GaleraLocalState(sqlCtx)
+	state, err := sqlClient.GaleraLocalStateNumber(sqlCtx)
 	if err != nil {
 		p.readinessLogger.Error(err, "error getting Pod state")
 		p.responseWriter.WriteError(w, "error getting Pod state")
 		return
 	}
-	if state == galeraclient.GaleraStateSynced {
+	if state == sql.GaleraNodeStateSynced {
 		p.responseWriter.WriteOK(w, nil)
 		return
 	}
@@ -140,7 +140,7 @@ func (p *Probe) Readiness(w http.ResponseWriter, r *http.Request) {
 	galera := ptr.Deref(mdb.Spec.Galera, mariadbv1alpha1.Galera{})
 	availableWhenDonor := ptr.Deref(galera.AvailableWhenDonor, false)
 
-	if availableWhenDonor && state == galeraclient.GaleraStateDonor {
+	if availableWhenDonor && state == sql.GaleraNodeStateDonor {
 		p.responseWriter.WriteOK(w, nil)
 		return
 	}
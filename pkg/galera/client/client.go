@@ -16,11 +16,6 @@ func IsPodHealthy(ctx context.Context, sqlClient *sql.Client) (bool, error) {
 	return status == "Primary", nil
 }
 
-var (
-	GaleraStateSynced string = "Synced"
-	GaleraStateDonor  string = "Donor/Desynced"
-)
-
 func IsPodSynced(ctx context.Context, sqlClient *sql.Client) (bool, error) {
 	healthy, err := IsPodHealthy(ctx, sqlClient)
 	if err != nil {
@@ -30,10 +25,10 @@ func IsPodSynced(ctx context.Context, sqlClient *sql.Client) (bool, error) {
 		return false, nil
 	}
 
-	state, err := sqlClient.GaleraLocalState(ctx)
+	state, err := sqlClient.GaleraLocalStateNumber(ctx)
 	if err != nil {
 		return false, fmt.Errorf("error getting local state: %v", err)
 	}
 
-	return state == GaleraStateSynced, nil
+	return state == sql.GaleraNodeStateSynced, nil
 }
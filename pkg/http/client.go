@@ -32,6 +32,9 @@ type Opts struct {
 	tlsCACert  []byte
 	tlsCert    []byte
 	tlsKey     []byte
+
+	retries      int
+	retryBackoff time.Duration
 }
 
 func WithHTTPClient(httpClient *http.Client) Option {
@@ -116,12 +119,30 @@ func WithTLSKey(tlsKey []byte) Option {
 	}
 }
 
+// WithRetry retries idempotent requests up to count times when they fail due to a transport error or a server error.
+// The backoff is doubled after every retry.
+func WithRetry(count int, backoff time.Duration) Option {
+	return func(opts *Opts) error {
+		if count < 0 {
+			return fmt.Errorf("invalid retry count: %d", count)
+		}
+		if backoff < 0 {
+			return fmt.Errorf("invalid retry backoff: %v", backoff)
+		}
+		opts.retries = count
+		opts.retryBackoff = backoff
+		return nil
+	}
+}
+
 type Client struct {
-	baseUrl    *url.URL
-	httpClient *http.Client
-	headers    map[string]string
-	version    string
-	logger     *logr.Logger
+	baseUrl      *url.URL
+	httpClient   *http.Client
+	headers      map[string]string
+	version      string
+	logger       *logr.Logger
+	retries      int
+	retryBackoff time.Duration
 }
 
 func NewClient(baseUrl string, opts ...Option) (*Client, error) {
@@ -143,11 +164,13 @@ func NewClient(baseUrl string, opts ...Option) (*Client, error) {
 	}
 
 	client := &Client{
-		baseUrl:    url,
-		httpClient: clientOpts.httpClient,
-		headers:    clientOpts.headers,
-		version:    clientOpts.version,
-		logger:     clientOpts.logger,
+		baseUrl:      url,
+		httpClient:   clientOpts.httpClient,
+		headers:      clientOpts.headers,
+		version:      clientOpts.version,
+		logger:       clientOpts.logger,
+		retries:      clientOpts.retries,
+		retryBackoff: clientOpts.retryBackoff,
 	}
 
 	transport, err := client.getTransport(&clientOpts)
@@ -182,11 +205,28 @@ func (c *Client) Request(ctx context.Context, method string, path string, body i
 }
 
 func (c *Client) Get(ctx context.Context, path string, query map[string]string) (*http.Response, error) {
-	req, err := c.NewRequestWithContext(ctx, http.MethodGet, path, nil, query)
-	if err != nil {
-		return nil, err
+	backoff := c.retryBackoff
+	for retry := 0; ; retry++ {
+		req, err := c.NewRequestWithContext(ctx, http.MethodGet, path, nil, query)
+		if err != nil {
+			return nil, err
+		}
+		res, err := c.Do(req)
+		if retry >= c.retries || !shouldRetry(ctx, res, err) {
+			return res, err
+		}
+		if res != nil {
+			res.Body.Close()
+		}
+		c.logDebug("Retrying request", "method", req.Method, "url", req.URL.String(), "retry", retry+1, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
 	}
-	return c.Do(req)
 }
 
 func (c *Client) Post(ctx context.Context, path string, body interface{}, query map[string]string) (*http.Response, error) {
@@ -205,6 +245,16 @@ func (c *Client) Delete(ctx context.Context, path string, body interface{}, quer
 	return c.Request(ctx, http.MethodDelete, path, body, query)
 }
 
+func shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return res.StatusCode >= http.StatusInternalServerError
+}
+
 func (c *Client) logRequest(req *http.Request) error {
 	c.logInfo("Request", "method", req.Method, "url", req.URL.String())
 	if req.Body != nil {
@@ -253,17 +303,20 @@ func (c *Client) getTransport(opts *Opts) (http.RoundTripper, error) {
 	if ok := caCertPool.AppendCertsFromPEM(opts.tlsCACert); !ok {
 		return nil, errors.New("unable to add CA cert to pool")
 	}
+	tlsConfig := &tls.Config{
+		RootCAs:            caCertPool,
+		InsecureSkipVerify: false,
+	}
 
-	cert, err := tls.X509KeyPair(opts.tlsCert, opts.tlsKey)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing x509 keypair: %v", err)
+	if opts.tlsCert != nil || opts.tlsKey != nil {
+		cert, err := tls.X509KeyPair(opts.tlsCert, opts.tlsKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing x509 keypair: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
 
 	return &http.Transport{
-		TLSClientConfig: &tls.Config{
-			RootCAs:            caCertPool,
-			Certificates:       []tls.Certificate{cert},
-			InsecureSkipVerify: false,
-		},
+		TLSClientConfig: tlsConfig,
 	}, nil
 }
@@ -0,0 +1,318 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mariadb-operator/mariadb-operator/pkg/pki"
+)
+
+func TestClientGetRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		retries      int
+		wantStatus   int
+		wantRequests int
+	}{
+		{
+			name:         "no retries",
+			failures:     1,
+			retries:      0,
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+		{
+			name:         "success after failures",
+			failures:     2,
+			retries:      3,
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+		},
+		{
+			name:         "retries exhausted",
+			failures:     5,
+			retries:      2,
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if int(requests.Add(1)) <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, WithRetry(tt.retries, time.Millisecond))
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %v", err)
+			}
+
+			res, err := client.Get(context.Background(), "/health", nil)
+			if err != nil {
+				t.Fatalf("unexpected error performing request: %v", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("unexpected status code: expected: %d, got: %d", tt.wantStatus, res.StatusCode)
+			}
+			if got := int(requests.Load()); got != tt.wantRequests {
+				t.Errorf("unexpected number of requests: expected: %d, got: %d", tt.wantRequests, got)
+			}
+		})
+	}
+}
+
+func TestClientGetRetryContextCancelled(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithRetry(10, 1*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Get(ctx, "/health", nil); err == nil {
+		t.Error("expect error to have occurred, got nil")
+	}
+	if got := int(requests.Load()); got != 1 {
+		t.Errorf("unexpected number of requests: expected: %d, got: %d", 1, got)
+	}
+}
+
+func TestWithRetryInvalid(t *testing.T) {
+	if _, err := NewClient("http://localhost", WithRetry(-1, time.Second)); err == nil {
+		t.Error("expect error to have occurred, got nil")
+	}
+	if _, err := NewClient("http://localhost", WithRetry(1, -time.Second)); err == nil {
+		t.Error("expect error to have occurred, got nil")
+	}
+}
+
+func TestClientMutualTLS(t *testing.T) {
+	caKeyPair, err := pki.CreateCA(pki.WithCommonName("agent-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+	serverKeyPair, err := pki.CreateCert(
+		caKeyPair,
+		pki.WithCommonName("localhost"),
+		pki.WithDNSNames("localhost"),
+		pki.WithExtKeyUsage(x509.ExtKeyUsageServerAuth),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating server cert: %v", err)
+	}
+	clientKeyPair, err := pki.CreateCert(
+		caKeyPair,
+		pki.WithCommonName("operator"),
+		pki.WithDNSNames("operator"),
+		pki.WithExtKeyUsage(x509.ExtKeyUsageClientAuth),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating client cert: %v", err)
+	}
+
+	serverCert, err := tls.X509KeyPair(serverKeyPair.CertPEM, serverKeyPair.KeyPEM)
+	if err != nil {
+		t.Fatalf("unexpected error parsing server keypair: %v", err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(caKeyPair.CertPEM)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error parsing server URL: %v", err)
+	}
+	baseUrl := fmt.Sprintf("https://localhost:%s", serverURL.Port())
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{
+			name: "client cert",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCA(caKeyPair.CertPEM),
+				WithTLSCert(clientKeyPair.CertPEM),
+				WithTLSKey(clientKeyPair.KeyPEM),
+			},
+			wantErr: false,
+		},
+		{
+			name: "no client cert",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCA(caKeyPair.CertPEM),
+			},
+			wantErr: true,
+		},
+		{
+			name: "untrusted CA",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCA(clientKeyPair.CertPEM),
+				WithTLSCert(clientKeyPair.CertPEM),
+				WithTLSKey(clientKeyPair.KeyPEM),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(baseUrl, append([]Option{WithHTTPClient(&http.Client{})}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %v", err)
+			}
+			res, err := client.Get(context.Background(), "/", nil)
+			if tt.wantErr {
+				if err == nil {
+					res.Body.Close()
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error performing request: %v", err)
+			}
+			defer res.Body.Close()
+			if res.StatusCode != http.StatusOK {
+				t.Errorf("expected status %d, got %d", http.StatusOK, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestClientTLSTransport(t *testing.T) {
+	caKeyPair, err := pki.CreateCA(pki.WithCommonName("agent-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+	clientKeyPair, err := pki.CreateCert(
+		caKeyPair,
+		pki.WithCommonName("operator"),
+		pki.WithDNSNames("operator"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating client cert: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantCerts int
+		wantErr   bool
+	}{
+		{
+			name: "CA and client cert",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCA(caKeyPair.CertPEM),
+				WithTLSCert(clientKeyPair.CertPEM),
+				WithTLSKey(clientKeyPair.KeyPEM),
+			},
+			wantCerts: 1,
+			wantErr:   false,
+		},
+		{
+			name: "CA only",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCA(caKeyPair.CertPEM),
+			},
+			wantCerts: 0,
+			wantErr:   false,
+		},
+		{
+			name: "missing CA",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCert(clientKeyPair.CertPEM),
+				WithTLSKey(clientKeyPair.KeyPEM),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing client key",
+			opts: []Option{
+				WithTLSEnabled(true),
+				WithTLSCA(caKeyPair.CertPEM),
+				WithTLSCert(clientKeyPair.CertPEM),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{}
+			opts := Opts{}
+			for _, setOpt := range tt.opts {
+				if err := setOpt(&opts); err != nil {
+					t.Fatalf("unexpected error setting option: %v", err)
+				}
+			}
+			roundTripper, err := client.getTransport(&opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error getting transport: %v", err)
+			}
+			transport, ok := roundTripper.(*http.Transport)
+			if !ok {
+				t.Fatalf("expected *http.Transport, got %T", roundTripper)
+			}
+			if transport.TLSClientConfig == nil || transport.TLSClientConfig.RootCAs == nil {
+				t.Fatal("expected TLS config with root CAs")
+			}
+			if len(transport.TLSClientConfig.Certificates) != tt.wantCerts {
+				t.Errorf("expected %d client certificates, got %d", tt.wantCerts, len(transport.TLSClientConfig.Certificates))
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package adapter_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/kubernetes/adapter"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestToKubernetesSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     []mariadbv1alpha1.EnvVar
+		wantEnv []corev1.EnvVar
+	}{
+		{
+			name:    "nil",
+			env:     nil,
+			wantEnv: nil,
+		},
+		{
+			name:    "empty",
+			env:     []mariadbv1alpha1.EnvVar{},
+			wantEnv: []corev1.EnvVar{},
+		},
+		{
+			name: "value",
+			env: []mariadbv1alpha1.EnvVar{
+				{
+					Name:  "FOO",
+					Value: "foo",
+				},
+			},
+			wantEnv: []corev1.EnvVar{
+				{
+					Name:  "FOO",
+					Value: "foo",
+				},
+			},
+		},
+		{
+			name: "ConfigMap and Secret key refs",
+			env: []mariadbv1alpha1.EnvVar{
+				{
+					Name: "CONFIG",
+					ValueFrom: &mariadbv1alpha1.EnvVarSource{
+						ConfigMapKeyRef: &mariadbv1alpha1.ConfigMapKeySelector{
+							LocalObjectReference: mariadbv1alpha1.LocalObjectReference{
+								Name: "config",
+							},
+							Key: "my.cnf",
+						},
+					},
+				},
+				{
+					Name: "PASSWORD",
+					ValueFrom: &mariadbv1alpha1.EnvVarSource{
+						SecretKeyRef: &mariadbv1alpha1.SecretKeySelector{
+							LocalObjectReference: mariadbv1alpha1.LocalObjectReference{
+								Name: "mariadb",
+							},
+							Key: "password",
+						},
+					},
+				},
+			},
+			wantEnv: []corev1.EnvVar{
+				{
+					Name: "CONFIG",
+					ValueFrom: &corev1.EnvVarSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "config",
+							},
+							Key: "my.cnf",
+						},
+					},
+				},
+				{
+					Name: "PASSWORD",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "mariadb",
+							},
+							Key: "password",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := adapter.ToKubernetesSlice(tt.env)
+			if diff := cmp.Diff(tt.wantEnv, env); diff != "" {
+				t.Errorf("unexpected env (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,11 +1,26 @@
 package metadata
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
 var (
-	WatchLabel = "k8s.mariadb.com/watch"
+	WatchLabel      = "k8s.mariadb.com/watch"
+	WatchLabelValue = ""
 
-	ReplicationAnnotation = "k8s.mariadb.com/replication"
-	GaleraAnnotation      = "k8s.mariadb.com/galera"
-	MariadbAnnotation     = "k8s.mariadb.com/mariadb"
+	ReplicationAnnotation                = "k8s.mariadb.com/replication"
+	GaleraAnnotation                     = "k8s.mariadb.com/galera"
+	GaleraExcludeAnnotation              = "k8s.mariadb.com/galera-exclude"
+	GaleraAbortRecoveryAnnotation        = "k8s.mariadb.com/galera-abort-recovery"
+	GaleraForceUnsafeBootstrapAnnotation = "k8s.mariadb.com/galera-force-unsafe-bootstrap"
+	MariadbAnnotation                    = "k8s.mariadb.com/mariadb"
 
 	ConfigAnnotation       = "k8s.mariadb.com/config"
 	ConfigTLSAnnotation    = "k8s.mariadb.com/config-tls"
@@ -18,4 +33,57 @@ var (
 	TLSListenerCertAnnotation = "k8s.mariadb.com/listener-cert"
 
 	WebhookConfigAnnotation = "k8s.mariadb.com/webhook"
+
+	PausedAnnotation            = "k8s.mariadb.com/paused"
+	ReconcileIntervalAnnotation = "k8s.mariadb.com/reconcile-interval"
 )
+
+// SetWatchLabel overrides the label used to watch external resources, such as Secrets and ConfigMaps.
+// It is meant to be called once at operator startup, before any controller is set up.
+func SetWatchLabel(key, value string) error {
+	if key == "" {
+		return errors.New("watch label key must be set")
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid watch label key '%s': %s", key, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return fmt.Errorf("invalid watch label value '%s': %s", value, strings.Join(errs, ", "))
+	}
+	WatchLabel = key
+	WatchLabelValue = value
+	return nil
+}
+
+// WatchLabelSelector returns a selector matching the resources labeled with the watch label.
+// When no value is set, the presence of the label key is enough to match.
+func WatchLabelSelector() labels.Selector {
+	operator := selection.Exists
+	var values []string
+	if WatchLabelValue != "" {
+		operator = selection.Equals
+		values = []string{WatchLabelValue}
+	}
+	req, err := labels.NewRequirement(WatchLabel, operator, values)
+	if err != nil {
+		return labels.Nothing()
+	}
+	return labels.NewSelector().Add(*req)
+}
+
+// ReconcileInterval returns the requeue interval defined by the ReconcileIntervalAnnotation.
+// It returns nil when the annotation is not present, and an error when it is not a positive duration.
+func ReconcileInterval(annotations map[string]string) (*time.Duration, error) {
+	value, ok := annotations[ReconcileIntervalAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing '%s' annotation: %v", ReconcileIntervalAnnotation, err)
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid '%s' annotation: interval must be positive, got %v", ReconcileIntervalAnnotation, interval)
+	}
+	return &interval, nil
+}
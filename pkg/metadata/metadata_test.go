@@ -0,0 +1,255 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+func TestSetWatchLabel(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		value     string
+		wantKey   string
+		wantValue string
+		wantErr   bool
+	}{
+		{
+			name:      "default",
+			key:       "k8s.mariadb.com/watch",
+			value:     "",
+			wantKey:   "k8s.mariadb.com/watch",
+			wantValue: "",
+			wantErr:   false,
+		},
+		{
+			name:      "custom key and value",
+			key:       "tenant.example.com/watch",
+			value:     "tenant-a",
+			wantKey:   "tenant.example.com/watch",
+			wantValue: "tenant-a",
+			wantErr:   false,
+		},
+		{
+			name:      "empty key",
+			key:       "",
+			value:     "tenant-a",
+			wantKey:   "k8s.mariadb.com/watch",
+			wantValue: "",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid key",
+			key:       "tenant.example.com/watch/invalid",
+			value:     "",
+			wantKey:   "k8s.mariadb.com/watch",
+			wantValue: "",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid value",
+			key:       "tenant.example.com/watch",
+			value:     "tenant a",
+			wantKey:   "k8s.mariadb.com/watch",
+			wantValue: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreWatchLabel(t)
+
+			err := SetWatchLabel(tt.key, tt.value)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if WatchLabel != tt.wantKey {
+				t.Errorf("unexpected watch label key, want: %s got: %s", tt.wantKey, WatchLabel)
+			}
+			if WatchLabelValue != tt.wantValue {
+				t.Errorf("unexpected watch label value, want: %s got: %s", tt.wantValue, WatchLabelValue)
+			}
+		})
+	}
+}
+
+func TestWatchLabelSelector(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		value        string
+		labels       map[string]string
+		wantSelector string
+		wantMatch    bool
+	}{
+		{
+			name:         "default label",
+			key:          "k8s.mariadb.com/watch",
+			value:        "",
+			labels:       map[string]string{"k8s.mariadb.com/watch": ""},
+			wantSelector: "k8s.mariadb.com/watch",
+			wantMatch:    true,
+		},
+		{
+			name:         "default label with any value",
+			key:          "k8s.mariadb.com/watch",
+			value:        "",
+			labels:       map[string]string{"k8s.mariadb.com/watch": "foo"},
+			wantSelector: "k8s.mariadb.com/watch",
+			wantMatch:    true,
+		},
+		{
+			name:         "default label missing",
+			key:          "k8s.mariadb.com/watch",
+			value:        "",
+			labels:       map[string]string{"app.kubernetes.io/name": "mariadb"},
+			wantSelector: "k8s.mariadb.com/watch",
+			wantMatch:    false,
+		},
+		{
+			name:         "custom label",
+			key:          "tenant.example.com/watch",
+			value:        "tenant-a",
+			labels:       map[string]string{"tenant.example.com/watch": "tenant-a"},
+			wantSelector: "tenant.example.com/watch=tenant-a",
+			wantMatch:    true,
+		},
+		{
+			name:         "custom label with another value",
+			key:          "tenant.example.com/watch",
+			value:        "tenant-a",
+			labels:       map[string]string{"tenant.example.com/watch": "tenant-b"},
+			wantSelector: "tenant.example.com/watch=tenant-a",
+			wantMatch:    false,
+		},
+		{
+			name:         "custom label with default label",
+			key:          "tenant.example.com/watch",
+			value:        "tenant-a",
+			labels:       map[string]string{"k8s.mariadb.com/watch": ""},
+			wantSelector: "tenant.example.com/watch=tenant-a",
+			wantMatch:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreWatchLabel(t)
+
+			if err := SetWatchLabel(tt.key, tt.value); err != nil {
+				t.Fatalf("unexpected error setting watch label: %v", err)
+			}
+			selector := WatchLabelSelector()
+
+			if selector.String() != tt.wantSelector {
+				t.Errorf("unexpected selector, want: %s got: %s", tt.wantSelector, selector.String())
+			}
+			if match := selector.Matches(labels.Set(tt.labels)); match != tt.wantMatch {
+				t.Errorf("unexpected selector match, want: %v got: %v", tt.wantMatch, match)
+			}
+		})
+	}
+}
+
+func restoreWatchLabel(t *testing.T) {
+	key, value := WatchLabel, WatchLabelValue
+	t.Cleanup(func() {
+		WatchLabel = key
+		WatchLabelValue = value
+	})
+}
+
+func TestReconcileInterval(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		wantInterval *time.Duration
+		wantErr      bool
+	}{
+		{
+			name:         "no annotations",
+			annotations:  nil,
+			wantInterval: nil,
+			wantErr:      false,
+		},
+		{
+			name: "no reconcile interval",
+			annotations: map[string]string{
+				"k8s.mariadb.com/paused": "true",
+			},
+			wantInterval: nil,
+			wantErr:      false,
+		},
+		{
+			name: "valid interval",
+			annotations: map[string]string{
+				"k8s.mariadb.com/reconcile-interval": "5m",
+			},
+			wantInterval: ptr.To(5 * time.Minute),
+			wantErr:      false,
+		},
+		{
+			name: "composite interval",
+			annotations: map[string]string{
+				"k8s.mariadb.com/reconcile-interval": "1h30m",
+			},
+			wantInterval: ptr.To(90 * time.Minute),
+			wantErr:      false,
+		},
+		{
+			name: "invalid interval",
+			annotations: map[string]string{
+				"k8s.mariadb.com/reconcile-interval": "foo",
+			},
+			wantInterval: nil,
+			wantErr:      true,
+		},
+		{
+			name: "missing unit",
+			annotations: map[string]string{
+				"k8s.mariadb.com/reconcile-interval": "30",
+			},
+			wantInterval: nil,
+			wantErr:      true,
+		},
+		{
+			name: "zero interval",
+			annotations: map[string]string{
+				"k8s.mariadb.com/reconcile-interval": "0s",
+			},
+			wantInterval: nil,
+			wantErr:      true,
+		},
+		{
+			name: "negative interval",
+			annotations: map[string]string{
+				"k8s.mariadb.com/reconcile-interval": "-1m",
+			},
+			wantInterval: nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interval, err := ReconcileInterval(tt.annotations)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantInterval, interval) {
+				t.Errorf("unexpected interval, want: %v got: %v", ptr.Deref(tt.wantInterval, 0), ptr.Deref(interval, 0))
+			}
+		})
+	}
+}
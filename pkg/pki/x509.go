@@ -8,12 +8,16 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net"
+	"net/url"
 	"time"
 )
 
 var (
 	DefaultCALifetime   = 3 * 365 * 24 * time.Hour // 3 years
 	DefaultCertLifetime = 3 * 30 * 24 * time.Hour  // 3 months
+	// DefaultClockSkew is the time NotBefore is backdated by, to tolerate clock skew between the issuer and the peers.
+	DefaultClockSkew = 1 * time.Hour
 
 	caMinLifetime = 1 * time.Hour
 	caMaxLifetime = 10 * 365 * 24 * time.Hour // 10 years
@@ -26,8 +30,16 @@ var (
 type X509Opts struct {
 	// CommonName is the common name for the certificate.
 	CommonName string
+	// Organization is a list of organizations for the certificate subject.
+	Organization []string
+	// OrganizationalUnit is a list of organizational units for the certificate subject.
+	OrganizationalUnit []string
 	// DNSNames is a list of DNS names for the certificate.
 	DNSNames []string
+	// IPAddresses is a list of IP addresses for the certificate.
+	IPAddresses []net.IP
+	// URIs is a list of URIs for the certificate, such as SPIFFE identities.
+	URIs []*url.URL
 	// NotBefore is the start time for the certificate's validity period.
 	NotBefore time.Time
 	// NotAfter is the end time for the certificate's validity period.
@@ -52,6 +64,20 @@ func WithCommonName(name string) X509Opt {
 	}
 }
 
+// WithOrganization sets the organizations for the certificate subject.
+func WithOrganization(organization ...string) X509Opt {
+	return func(x *X509Opts) {
+		x.Organization = organization
+	}
+}
+
+// WithOrganizationalUnit sets the organizational units for the certificate subject.
+func WithOrganizationalUnit(organizationalUnit ...string) X509Opt {
+	return func(x *X509Opts) {
+		x.OrganizationalUnit = organizationalUnit
+	}
+}
+
 // WithDNSNames sets the DNS names for the certificate.
 func WithDNSNames(dnsNames ...string) X509Opt {
 	return func(x *X509Opts) {
@@ -59,6 +85,20 @@ func WithDNSNames(dnsNames ...string) X509Opt {
 	}
 }
 
+// WithIPAddresses sets the IP addresses for the certificate.
+func WithIPAddresses(ips ...net.IP) X509Opt {
+	return func(x *X509Opts) {
+		x.IPAddresses = ips
+	}
+}
+
+// WithURIs sets the URIs for the certificate.
+func WithURIs(uris ...*url.URL) X509Opt {
+	return func(x *X509Opts) {
+		x.URIs = uris
+	}
+}
+
 // WithNotBefore sets the start time for the certificate's validity period.
 func WithNotBefore(notBefore time.Time) X509Opt {
 	return func(x *X509Opts) {
@@ -104,7 +144,7 @@ func WithKeyPairOpts(keyPairOpts ...KeyPairOpt) X509Opt {
 // CreateCA creates a new CA certificate with the given options.
 func CreateCA(x509Opts ...X509Opt) (*KeyPair, error) {
 	opts := X509Opts{
-		NotBefore: time.Now().Add(-1 * time.Hour),
+		NotBefore: time.Now().Add(-DefaultClockSkew),
 		NotAfter:  time.Now().Add(DefaultCALifetime),
 	}
 	for _, setOpt := range x509Opts {
@@ -142,7 +182,7 @@ func CreateCA(x509Opts ...X509Opt) (*KeyPair, error) {
 // CreateCert creates a new certificate signed by the given CA key pair with the given options.
 func CreateCert(caKeyPair *KeyPair, x509Opts ...X509Opt) (*KeyPair, error) {
 	opts := X509Opts{
-		NotBefore: time.Now().Add(-1 * time.Hour),
+		NotBefore: time.Now().Add(-DefaultClockSkew),
 		NotAfter:  time.Now().Add(DefaultCertLifetime),
 		KeyUsage:  x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
 		IsCA:      false,
@@ -165,9 +205,13 @@ func CreateCert(caKeyPair *KeyPair, x509Opts ...X509Opt) (*KeyPair, error) {
 	tpl := &x509.Certificate{
 		SerialNumber: serialNumber,
 		Subject: pkix.Name{
-			CommonName: opts.CommonName,
+			CommonName:         opts.CommonName,
+			Organization:       opts.Organization,
+			OrganizationalUnit: opts.OrganizationalUnit,
 		},
 		DNSNames:              opts.DNSNames,
+		IPAddresses:           opts.IPAddresses,
+		URIs:                  opts.URIs,
 		NotBefore:             opts.NotBefore,
 		NotAfter:              opts.NotAfter,
 		KeyUsage:              opts.KeyUsage,
@@ -2,6 +2,8 @@ package pki
 
 import (
 	"crypto/x509"
+	"net"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
@@ -138,6 +140,40 @@ func TestCreateCert(t *testing.T) {
 				wantExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
 				wantIsCA:        false,
 			},
+			{
+				name: "IP and URI SANs",
+				x509Opts: []X509Opt{
+					WithCommonName("ip-uri-sans"),
+					WithDNSNames("ip-uri-sans"),
+					WithIPAddresses(net.ParseIP("10.244.0.12"), net.ParseIP("2001:db8::a1")),
+					WithURIs(&url.URL{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/default/sa/mariadb"}),
+				},
+				wantErr:         false,
+				wantCommonName:  "ip-uri-sans",
+				wantIssuer:      caName,
+				wantDNSNames:    []string{"ip-uri-sans"},
+				wantKeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
+				wantIPAddresses: []string{"10.244.0.12", "2001:db8::a1"},
+				wantURIs:        []string{"spiffe://cluster.local/ns/default/sa/mariadb"},
+				wantIsCA:        false,
+			},
+			{
+				name: "Subject organization",
+				x509Opts: []X509Opt{
+					WithCommonName("subject-org"),
+					WithDNSNames("subject-org"),
+					WithOrganization("mariadb-operator"),
+					WithOrganizationalUnit("database", "platform"),
+				},
+				wantErr:        false,
+				wantCommonName: "subject-org",
+				wantIssuer:     caName,
+				wantDNSNames:   []string{"subject-org"},
+				wantKeyUsage:   x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
+				wantOrg:        []string{"mariadb-operator"},
+				wantOU:         []string{"database", "platform"},
+				wantIsCA:       false,
+			},
 		},
 		func(opts ...X509Opt) (*KeyPair, error) {
 			return CreateCert(caKeyPair, opts...)
@@ -655,6 +691,10 @@ type testCaseCreateCert struct {
 	wantDNSNames    []string
 	wantKeyUsage    x509.KeyUsage
 	wantExtKeyUsage []x509.ExtKeyUsage
+	wantIPAddresses []string
+	wantURIs        []string
+	wantOrg         []string
+	wantOU          []string
 	wantIsCA        bool
 }
 
@@ -699,6 +739,26 @@ func testCreateCert(
 			if !reflect.DeepEqual(cert.ExtKeyUsage, tt.wantExtKeyUsage) {
 				t.Fatalf("unexpected extended key usage, got: %v, want: %v", cert.ExtKeyUsage, tt.wantExtKeyUsage)
 			}
+			var ipAddresses []string
+			for _, ip := range cert.IPAddresses {
+				ipAddresses = append(ipAddresses, ip.String())
+			}
+			if !reflect.DeepEqual(ipAddresses, tt.wantIPAddresses) {
+				t.Fatalf("unexpected IP addresses, got: %v, want: %v", ipAddresses, tt.wantIPAddresses)
+			}
+			var uris []string
+			for _, uri := range cert.URIs {
+				uris = append(uris, uri.String())
+			}
+			if !reflect.DeepEqual(uris, tt.wantURIs) {
+				t.Fatalf("unexpected URIs, got: %v, want: %v", uris, tt.wantURIs)
+			}
+			if !reflect.DeepEqual(cert.Subject.Organization, tt.wantOrg) {
+				t.Fatalf("unexpected organization, got: %v, want: %v", cert.Subject.Organization, tt.wantOrg)
+			}
+			if !reflect.DeepEqual(cert.Subject.OrganizationalUnit, tt.wantOU) {
+				t.Fatalf("unexpected organizational unit, got: %v, want: %v", cert.Subject.OrganizationalUnit, tt.wantOU)
+			}
 			if cert.IsCA != tt.wantIsCA {
 				t.Fatalf("unexpected IsCA, got: %v, want: %v", cert.IsCA, tt.wantIsCA)
 			}
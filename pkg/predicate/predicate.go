@@ -1,6 +1,7 @@
 package predicate
 
 import (
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -29,6 +30,23 @@ func PredicateWithLabel(label string) predicate.Predicate {
 	}
 }
 
+func PredicateWithLabelSelector(selector labels.Selector) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return matchesSelector(e.Object, selector)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return matchesSelector(e.Object, selector)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return matchesSelector(e.ObjectNew, selector)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return matchesSelector(e.Object, selector)
+		},
+	}
+}
+
 func PredicateChangedWithAnnotations(annotations []string, hasChanged func(old, new client.Object) bool) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
@@ -59,6 +77,10 @@ func hasAnnotations(o client.Object, annotations []string) bool {
 	return true
 }
 
+func matchesSelector(o client.Object, selector labels.Selector) bool {
+	return selector.Matches(labels.Set(o.GetLabels()))
+}
+
 func hasLabel(o client.Object, label string) bool {
 	_, hasLabel := o.GetLabels()[label]
 	return hasLabel
@@ -0,0 +1,96 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Account represents a MariaDB account, composed by a user and a host.
+type Account struct {
+	User string
+	Host string
+}
+
+// NewAccount returns a new Account, defaulting to any host when the host is empty.
+func NewAccount(user, host string) Account {
+	if host == "" {
+		host = "%"
+	}
+	return Account{
+		User: user,
+		Host: host,
+	}
+}
+
+// ParseAccount parses an account name in any of the formats supported by MariaDB: user, user@host, 'user'@'host',
+// "user"@"host" or `user`@`host`. The host defaults to any host when it is not provided.
+func ParseAccount(s string) (Account, error) {
+	user, rest, err := parseAccountPart(strings.TrimSpace(s))
+	if err != nil {
+		return Account{}, fmt.Errorf("error parsing user: %v", err)
+	}
+	if user == "" {
+		return Account{}, errors.New("user must not be empty")
+	}
+	if rest == "" {
+		return NewAccount(user, ""), nil
+	}
+	if !strings.HasPrefix(rest, "@") {
+		return Account{}, fmt.Errorf("unexpected characters after user: '%s'", rest)
+	}
+
+	host, rest, err := parseAccountPart(rest[1:])
+	if err != nil {
+		return Account{}, fmt.Errorf("error parsing host: %v", err)
+	}
+	if host == "" {
+		return Account{}, errors.New("host must not be empty")
+	}
+	if rest != "" {
+		return Account{}, fmt.Errorf("unexpected characters after host: '%s'", rest)
+	}
+	return NewAccount(user, host), nil
+}
+
+// String returns the account name in user@host format. It is not meant to be used in SQL statements, use Quoted instead.
+func (a Account) String() string {
+	return fmt.Sprintf("%s@%s", a.User, a.Host)
+}
+
+// Quoted returns the account name in 'user'@'host' format, escaping the user and the host to be safely used in SQL statements.
+func (a Account) Quoted() string {
+	return fmt.Sprintf("%s@%s", StringLiteral(a.User), StringLiteral(a.Host))
+}
+
+func parseAccountPart(s string) (value string, rest string, err error) {
+	if s == "" {
+		return "", "", nil
+	}
+	quote := s[0]
+	if quote != '\'' && quote != '"' && quote != '`' {
+		idx := strings.Index(s, "@")
+		if idx == -1 {
+			return s, "", nil
+		}
+		return s[:idx], s[idx:], nil
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && quote != '`' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+			b.WriteByte(quote)
+		case c == quote:
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quote in '%s'", s)
+}
@@ -0,0 +1,222 @@
+package sql
+
+import (
+	"testing"
+)
+
+func TestParseAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		account     string
+		wantAccount Account
+		wantErr     bool
+	}{
+		{
+			name:        "empty",
+			account:     "",
+			wantAccount: Account{},
+			wantErr:     true,
+		},
+		{
+			name:        "user",
+			account:     "bob",
+			wantAccount: Account{User: "bob", Host: "%"},
+			wantErr:     false,
+		},
+		{
+			name:        "user and host",
+			account:     "bob@localhost",
+			wantAccount: Account{User: "bob", Host: "localhost"},
+			wantErr:     false,
+		},
+		{
+			name:        "quoted user and host",
+			account:     "'bob'@'localhost'",
+			wantAccount: Account{User: "bob", Host: "localhost"},
+			wantErr:     false,
+		},
+		{
+			name:        "quoted user",
+			account:     "'bob'",
+			wantAccount: Account{User: "bob", Host: "%"},
+			wantErr:     false,
+		},
+		{
+			name:        "double quoted",
+			account:     `"bob"@"localhost"`,
+			wantAccount: Account{User: "bob", Host: "localhost"},
+			wantErr:     false,
+		},
+		{
+			name:        "backtick quoted",
+			account:     "`bob`@`localhost`",
+			wantAccount: Account{User: "bob", Host: "localhost"},
+			wantErr:     false,
+		},
+		{
+			name:        "dashes",
+			account:     "'mariadb-galera-repl'@'mariadb-galera-0.mariadb-galera-internal'",
+			wantAccount: Account{User: "mariadb-galera-repl", Host: "mariadb-galera-0.mariadb-galera-internal"},
+			wantErr:     false,
+		},
+		{
+			name:        "IP host",
+			account:     "'bob'@'10.244.0.12'",
+			wantAccount: Account{User: "bob", Host: "10.244.0.12"},
+			wantErr:     false,
+		},
+		{
+			name:        "IPv6 host",
+			account:     "'bob'@'::1'",
+			wantAccount: Account{User: "bob", Host: "::1"},
+			wantErr:     false,
+		},
+		{
+			name:        "wildcard host",
+			account:     "'bob'@'%'",
+			wantAccount: Account{User: "bob", Host: "%"},
+			wantErr:     false,
+		},
+		{
+			name:        "wildcard subnet host",
+			account:     "'bob'@'10.244.%'",
+			wantAccount: Account{User: "bob", Host: "10.244.%"},
+			wantErr:     false,
+		},
+		{
+			name:        "doubled embedded quote",
+			account:     "'o''brien'@'%'",
+			wantAccount: Account{User: "o'brien", Host: "%"},
+			wantErr:     false,
+		},
+		{
+			name:        "escaped embedded quote",
+			account:     `'o\'brien'@'%'`,
+			wantAccount: Account{User: "o'brien", Host: "%"},
+			wantErr:     false,
+		},
+		{
+			name:        "at sign in quoted user",
+			account:     "'bob@example.com'@'%'",
+			wantAccount: Account{User: "bob@example.com", Host: "%"},
+			wantErr:     false,
+		},
+		{
+			name:        "unterminated quote",
+			account:     "'bob@'%'",
+			wantAccount: Account{},
+			wantErr:     true,
+		},
+		{
+			name:        "empty user",
+			account:     "''@'%'",
+			wantAccount: Account{},
+			wantErr:     true,
+		},
+		{
+			name:        "empty host",
+			account:     "'bob'@",
+			wantAccount: Account{},
+			wantErr:     true,
+		},
+		{
+			name:        "trailing characters",
+			account:     "'bob'@'%'; DROP USER root",
+			wantAccount: Account{},
+			wantErr:     true,
+		},
+		{
+			name:        "missing at sign",
+			account:     "'bob''%'x",
+			wantAccount: Account{},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := ParseAccount(tt.account)
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if account != tt.wantAccount {
+				t.Errorf("unexpected account, want: %v got: %v", tt.wantAccount, account)
+			}
+		})
+	}
+}
+
+func TestAccountQuoted(t *testing.T) {
+	tests := []struct {
+		name       string
+		account    Account
+		wantString string
+		wantQuoted string
+	}{
+		{
+			name:       "default host",
+			account:    NewAccount("bob", ""),
+			wantString: "bob@%",
+			wantQuoted: "'bob'@'%'",
+		},
+		{
+			name:       "dashes",
+			account:    NewAccount("mariadb-galera-repl", "mariadb-galera-0.mariadb-galera-internal"),
+			wantString: "mariadb-galera-repl@mariadb-galera-0.mariadb-galera-internal",
+			wantQuoted: "'mariadb-galera-repl'@'mariadb-galera-0.mariadb-galera-internal'",
+		},
+		{
+			name:       "IP host",
+			account:    NewAccount("bob", "10.244.0.12"),
+			wantString: "bob@10.244.0.12",
+			wantQuoted: "'bob'@'10.244.0.12'",
+		},
+		{
+			name:       "wildcard host",
+			account:    NewAccount("bob", "10.244.%"),
+			wantString: "bob@10.244.%",
+			wantQuoted: "'bob'@'10.244.%'",
+		},
+		{
+			name:       "embedded quote",
+			account:    NewAccount("o'brien", "%"),
+			wantString: "o'brien@%",
+			wantQuoted: "'o''brien'@'%'",
+		},
+		{
+			name:       "injection attempt",
+			account:    NewAccount("bob'@'%'; DROP USER 'root", "%"),
+			wantString: "bob'@'%'; DROP USER 'root@%",
+			wantQuoted: "'bob''@''%''; DROP USER ''root'@'%'",
+		},
+		{
+			name:       "backslash",
+			account:    NewAccount(`bob\`, "%"),
+			wantString: `bob\@%`,
+			wantQuoted: `'bob\\'@'%'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if s := tt.account.String(); s != tt.wantString {
+				t.Errorf("unexpected string, want: %s got: %s", tt.wantString, s)
+			}
+			quoted := tt.account.Quoted()
+			if quoted != tt.wantQuoted {
+				t.Errorf("unexpected quoted string, want: %s got: %s", tt.wantQuoted, quoted)
+			}
+
+			account, err := ParseAccount(quoted)
+			if err != nil {
+				t.Fatalf("unexpected error parsing quoted account: %v", err)
+			}
+			if account != tt.account {
+				t.Errorf("unexpected account after parsing quoted account, want: %v got: %v", tt.account, account)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// usagePrivilege is the baseline privilege that every account has, meaning no privileges. It cannot be revoked.
+const usagePrivilege = "USAGE"
+
+// Grant represents a set of privileges granted to an account on a database object, as reported by SHOW GRANTS.
+type Grant struct {
+	// Privileges granted on the object, normalized to upper case.
+	Privileges []string
+	// Object the privileges apply to, rendered as in the ON clause, i.e. *.*, `db`.* or `db`.`table`.
+	Object string
+	// GrantOption indicates whether the account is able to grant its privileges on the object.
+	GrantOption bool
+}
+
+// NewGrant returns a new Grant on a database and a table, where any of them can be a wildcard.
+func NewGrant(privileges []string, database, table string, grantOption bool) (Grant, error) {
+	if err := ValidatePrivileges(privileges); err != nil {
+		return Grant{}, fmt.Errorf("invalid privileges: %v", err)
+	}
+	object, err := grantObject("", database, table)
+	if err != nil {
+		return Grant{}, err
+	}
+	return Grant{
+		Privileges:  normalizePrivileges(privileges),
+		Object:      object,
+		GrantOption: grantOption,
+	}, nil
+}
+
+// ShowGrants returns the GRANT statements of an account.
+func (c *Client) ShowGrants(ctx context.Context, account Account) ([]string, error) {
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf("SHOW GRANTS FOR %s;", account.Quoted()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []string
+	for rows.Next() {
+		var grant string
+		if err := rows.Scan(&grant); err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// ListGrantsForUser returns the privileges granted to an account name, in any of the formats supported by ParseAccount.
+func (c *Client) ListGrantsForUser(ctx context.Context, accountName string) ([]Grant, error) {
+	account, err := ParseAccount(accountName)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing account: %v", err)
+	}
+	return c.ListGrantsForAccount(ctx, account)
+}
+
+// ListGrantsForAccount returns the privileges granted to an account on database objects. Role and PROXY grants are not returned.
+func (c *Client) ListGrantsForAccount(ctx context.Context, account Account) ([]Grant, error) {
+	statements, err := c.ShowGrants(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("error showing grants: %v", err)
+	}
+
+	var grants []Grant
+	for _, statement := range statements {
+		grant, err := parseGrant(statement)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing grant '%s': %v", statement, err)
+		}
+		if grant != nil {
+			grants = append(grants, *grant)
+		}
+	}
+	return grants, nil
+}
+
+// RevokeGrant revokes the privileges of a Grant, including GRANT OPTION when set.
+func (c *Client) RevokeGrant(ctx context.Context, grant Grant, account Account) error {
+	query, err := buildRevokeGrantQuery(grant, account)
+	if err != nil {
+		return fmt.Errorf("error building REVOKE query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildRevokeGrantQuery(grant Grant, account Account) (string, error) {
+	privileges := grant.Privileges
+	if grant.GrantOption {
+		privileges = append(privileges[:len(privileges):len(privileges)], "GRANT OPTION")
+	}
+	if len(privileges) == 0 {
+		return "", fmt.Errorf("no privileges to revoke on %s", grant.Object)
+	}
+	if grant.Object == "" {
+		return "", fmt.Errorf("object must be provided")
+	}
+	return fmt.Sprintf("REVOKE %s ON %s FROM %s;",
+		strings.Join(privileges, ","),
+		grant.Object,
+		account.Quoted(),
+	), nil
+}
+
+// OrphanedGrants returns the actual privileges that are not part of the desired Grants, grouped by object.
+// The USAGE baseline and column privileges are never returned, as they cannot be revoked or are not managed, respectively.
+func OrphanedGrants(desired, actual []Grant) []Grant {
+	desiredPrivs := make(map[string]map[string]struct{})
+	desiredGrantOption := make(map[string]bool)
+	for _, grant := range desired {
+		privs, ok := desiredPrivs[grant.Object]
+		if !ok {
+			privs = make(map[string]struct{})
+			desiredPrivs[grant.Object] = privs
+		}
+		for _, priv := range normalizePrivileges(grant.Privileges) {
+			privs[priv] = struct{}{}
+		}
+		if _, ok := privs["GRANT OPTION"]; ok || grant.GrantOption {
+			desiredGrantOption[grant.Object] = true
+		}
+	}
+
+	var orphaned []Grant
+	for _, grant := range actual {
+		privs := desiredPrivs[grant.Object]
+		_, allPrivs := privs["ALL PRIVILEGES"]
+
+		orphan := Grant{
+			Object:      grant.Object,
+			GrantOption: grant.GrantOption && !desiredGrantOption[grant.Object],
+		}
+		for _, priv := range normalizePrivileges(grant.Privileges) {
+			if priv == usagePrivilege || strings.Contains(priv, "(") {
+				continue
+			}
+			if _, ok := privs[priv]; ok || allPrivs {
+				continue
+			}
+			orphan.Privileges = append(orphan.Privileges, priv)
+		}
+		if len(orphan.Privileges) > 0 || orphan.GrantOption {
+			orphaned = append(orphaned, orphan)
+		}
+	}
+	return orphaned
+}
+
+// parseGrant parses a GRANT statement as returned by SHOW GRANTS. It returns nil for role and PROXY grants.
+func parseGrant(statement string) (*Grant, error) {
+	statement = strings.TrimSuffix(strings.TrimSpace(statement), ";")
+	if !strings.HasPrefix(strings.ToUpper(statement), "GRANT ") {
+		return nil, fmt.Errorf("statement must start with GRANT")
+	}
+	statement = statement[len("GRANT "):]
+
+	onIdx := indexKeyword(statement, " ON ")
+	if onIdx == -1 {
+		// Role grants have no ON clause, i.e. GRANT `role` TO `user`@`host`.
+		return nil, nil
+	}
+	privileges := splitPrivileges(statement[:onIdx])
+	if len(privileges) == 1 && privileges[0] == "PROXY" {
+		return nil, nil
+	}
+	rest := statement[onIdx+len(" ON "):]
+
+	toIdx := indexKeyword(rest, " TO ")
+	if toIdx == -1 {
+		return nil, fmt.Errorf("missing TO clause")
+	}
+	object := strings.TrimSpace(rest[:toIdx])
+	if object == "" {
+		return nil, fmt.Errorf("missing object")
+	}
+
+	return &Grant{
+		Privileges:  privileges,
+		Object:      object,
+		GrantOption: indexKeyword(rest[toIdx:], " WITH GRANT OPTION") != -1,
+	}, nil
+}
+
+func splitPrivileges(s string) []string {
+	var privileges []string
+	for {
+		idx := indexKeyword(s, ",")
+		if idx == -1 {
+			break
+		}
+		privileges = append(privileges, s[:idx])
+		s = s[idx+1:]
+	}
+	return normalizePrivileges(append(privileges, s))
+}
+
+func normalizePrivileges(privileges []string) []string {
+	normalized := make([]string, 0, len(privileges))
+	for _, priv := range privileges {
+		name := strings.ToUpper(strings.Join(strings.Fields(priv), " "))
+		if name == "" {
+			continue
+		}
+		if name == "ALL" {
+			name = "ALL PRIVILEGES"
+		}
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
+
+// indexKeyword returns the index of the first occurrence of a keyword in s, ignoring the ones within quotes and parentheses.
+func indexKeyword(s, keyword string) int {
+	var quote byte
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' && i+1 < len(s) {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth > 0 {
+				depth--
+			}
+		case depth == 0 && len(s)-i >= len(keyword) && strings.EqualFold(s[i:i+len(keyword)], keyword):
+			return i
+		}
+	}
+	return -1
+}
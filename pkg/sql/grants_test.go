@@ -0,0 +1,336 @@
+package sql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseGrant(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		wantGrant *Grant
+		wantErr   bool
+	}{
+		{
+			name:      "usage",
+			statement: "GRANT USAGE ON *.* TO `bob`@`%` IDENTIFIED BY PASSWORD '*6C8989366EAF75BB670AD8EA7A7FC1176A95CEF4'",
+			wantGrant: &Grant{Privileges: []string{"USAGE"}, Object: "*.*"},
+			wantErr:   false,
+		},
+		{
+			name:      "database",
+			statement: "GRANT SELECT, INSERT, UPDATE ON `db`.* TO `bob`@`%`",
+			wantGrant: &Grant{Privileges: []string{"SELECT", "INSERT", "UPDATE"}, Object: "`db`.*"},
+			wantErr:   false,
+		},
+		{
+			name:      "table with grant option",
+			statement: "GRANT ALL PRIVILEGES ON `db`.`table` TO `bob`@`localhost` WITH GRANT OPTION",
+			wantGrant: &Grant{Privileges: []string{"ALL PRIVILEGES"}, Object: "`db`.`table`", GrantOption: true},
+			wantErr:   false,
+		},
+		{
+			name:      "keywords in identifiers",
+			statement: "GRANT SELECT ON `db on`.`to table` TO `bob with grant option`@`%`",
+			wantGrant: &Grant{Privileges: []string{"SELECT"}, Object: "`db on`.`to table`"},
+			wantErr:   false,
+		},
+		{
+			name:      "column privileges",
+			statement: "GRANT SELECT (`a`, `b`), INSERT ON `db`.`table` TO `bob`@`%`",
+			wantGrant: &Grant{Privileges: []string{"SELECT (`A`, `B`)", "INSERT"}, Object: "`db`.`table`"},
+			wantErr:   false,
+		},
+		{
+			name:      "procedure",
+			statement: "GRANT EXECUTE ON PROCEDURE `db`.`proc` TO `bob`@`%`",
+			wantGrant: &Grant{Privileges: []string{"EXECUTE"}, Object: "PROCEDURE `db`.`proc`"},
+			wantErr:   false,
+		},
+		{
+			name:      "role",
+			statement: "GRANT `admin` TO `bob`@`%`",
+			wantGrant: nil,
+			wantErr:   false,
+		},
+		{
+			name:      "proxy",
+			statement: "GRANT PROXY ON ''@'%' TO 'root'@'localhost' WITH GRANT OPTION",
+			wantGrant: nil,
+			wantErr:   false,
+		},
+		{
+			name:      "not a grant",
+			statement: "REVOKE SELECT ON *.* FROM `bob`@`%`",
+			wantGrant: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "missing TO",
+			statement: "GRANT SELECT ON *.*",
+			wantGrant: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant, err := parseGrant(tt.statement)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantGrant, grant) {
+				t.Errorf("unexpected grant, want: %v got: %v", tt.wantGrant, grant)
+			}
+		})
+	}
+}
+
+func TestNewGrant(t *testing.T) {
+	tests := []struct {
+		name        string
+		privileges  []string
+		database    string
+		table       string
+		grantOption bool
+		wantGrant   Grant
+		wantErr     bool
+	}{
+		{
+			name:       "global",
+			privileges: []string{"select", "Insert"},
+			database:   "*",
+			table:      "*",
+			wantGrant:  Grant{Privileges: []string{"SELECT", "INSERT"}, Object: "*.*"},
+			wantErr:    false,
+		},
+		{
+			name:        "database",
+			privileges:  []string{"ALL"},
+			database:    "db",
+			table:       "*",
+			grantOption: true,
+			wantGrant:   Grant{Privileges: []string{"ALL PRIVILEGES"}, Object: "`db`.*", GrantOption: true},
+			wantErr:     false,
+		},
+		{
+			name:       "table",
+			privileges: []string{"SELECT"},
+			database:   "db",
+			table:      "table",
+			wantGrant:  Grant{Privileges: []string{"SELECT"}, Object: "`db`.`table`"},
+			wantErr:    false,
+		},
+		{
+			name:       "invalid privilege",
+			privileges: []string{"FOO"},
+			database:   "*",
+			table:      "*",
+			wantGrant:  Grant{},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant, err := NewGrant(tt.privileges, tt.database, tt.table, tt.grantOption)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantGrant, grant) {
+				t.Errorf("unexpected grant, want: %v got: %v", tt.wantGrant, grant)
+			}
+		})
+	}
+}
+
+func TestOrphanedGrants(t *testing.T) {
+	tests := []struct {
+		name         string
+		desired      []Grant
+		actual       []Grant
+		wantOrphaned []Grant
+	}{
+		{
+			name:         "no grants",
+			desired:      nil,
+			actual:       nil,
+			wantOrphaned: nil,
+		},
+		{
+			name:    "usage",
+			desired: nil,
+			actual: []Grant{
+				{Privileges: []string{"USAGE"}, Object: "*.*"},
+			},
+			wantOrphaned: nil,
+		},
+		{
+			name: "in sync",
+			desired: []Grant{
+				{Privileges: []string{"SELECT", "INSERT"}, Object: "`db`.*"},
+			},
+			actual: []Grant{
+				{Privileges: []string{"USAGE"}, Object: "*.*"},
+				{Privileges: []string{"INSERT", "SELECT"}, Object: "`db`.*"},
+			},
+			wantOrphaned: nil,
+		},
+		{
+			name: "orphaned object",
+			desired: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "`db`.*"},
+			},
+			actual: []Grant{
+				{Privileges: []string{"USAGE"}, Object: "*.*"},
+				{Privileges: []string{"SELECT"}, Object: "`db`.*"},
+				{Privileges: []string{"SELECT", "UPDATE"}, Object: "`other`.*"},
+			},
+			wantOrphaned: []Grant{
+				{Privileges: []string{"SELECT", "UPDATE"}, Object: "`other`.*"},
+			},
+		},
+		{
+			name: "orphaned privilege",
+			desired: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "`db`.*"},
+			},
+			actual: []Grant{
+				{Privileges: []string{"SELECT", "DELETE"}, Object: "`db`.*"},
+			},
+			wantOrphaned: []Grant{
+				{Privileges: []string{"DELETE"}, Object: "`db`.*"},
+			},
+		},
+		{
+			name: "privileges across Grants",
+			desired: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "`db`.*"},
+				{Privileges: []string{"delete"}, Object: "`db`.*"},
+			},
+			actual: []Grant{
+				{Privileges: []string{"SELECT", "DELETE"}, Object: "`db`.*"},
+			},
+			wantOrphaned: nil,
+		},
+		{
+			name: "all privileges",
+			desired: []Grant{
+				{Privileges: []string{"ALL"}, Object: "`db`.*"},
+			},
+			actual: []Grant{
+				{Privileges: []string{"ALL PRIVILEGES"}, Object: "`db`.*"},
+			},
+			wantOrphaned: nil,
+		},
+		{
+			name: "orphaned grant option",
+			desired: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "*.*"},
+			},
+			actual: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "*.*", GrantOption: true},
+			},
+			wantOrphaned: []Grant{
+				{Object: "*.*", GrantOption: true},
+			},
+		},
+		{
+			name: "grant option",
+			desired: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "*.*", GrantOption: true},
+			},
+			actual: []Grant{
+				{Privileges: []string{"SELECT"}, Object: "*.*", GrantOption: true},
+			},
+			wantOrphaned: nil,
+		},
+		{
+			name:    "column privileges",
+			desired: nil,
+			actual: []Grant{
+				{Privileges: []string{"SELECT (`A`)", "UPDATE"}, Object: "`db`.`table`"},
+			},
+			wantOrphaned: []Grant{
+				{Privileges: []string{"UPDATE"}, Object: "`db`.`table`"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orphaned := OrphanedGrants(tt.desired, tt.actual)
+			if !reflect.DeepEqual(tt.wantOrphaned, orphaned) {
+				t.Errorf("unexpected orphaned grants, want: %v got: %v", tt.wantOrphaned, orphaned)
+			}
+		})
+	}
+}
+
+func TestBuildRevokeGrantQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		grant     Grant
+		account   Account
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "privileges",
+			grant:     Grant{Privileges: []string{"SELECT", "UPDATE"}, Object: "`db`.*"},
+			account:   NewAccount("bob", "%"),
+			wantQuery: "REVOKE SELECT,UPDATE ON `db`.* FROM 'bob'@'%';",
+			wantErr:   false,
+		},
+		{
+			name:      "privileges and grant option",
+			grant:     Grant{Privileges: []string{"ALL PRIVILEGES"}, Object: "*.*", GrantOption: true},
+			account:   NewAccount("bob", "localhost"),
+			wantQuery: "REVOKE ALL PRIVILEGES,GRANT OPTION ON *.* FROM 'bob'@'localhost';",
+			wantErr:   false,
+		},
+		{
+			name:      "grant option",
+			grant:     Grant{Object: "`db`.`table`", GrantOption: true},
+			account:   NewAccount("bob", "%"),
+			wantQuery: "REVOKE GRANT OPTION ON `db`.`table` FROM 'bob'@'%';",
+			wantErr:   false,
+		},
+		{
+			name:      "no privileges",
+			grant:     Grant{Object: "*.*"},
+			account:   NewAccount("bob", "%"),
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "no object",
+			grant:     Grant{Privileges: []string{"SELECT"}},
+			account:   NewAccount("bob", "%"),
+			wantQuery: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := buildRevokeGrantQuery(tt.grant, tt.account)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query != tt.wantQuery {
+				t.Errorf("unexpected query, want: %s got: %s", tt.wantQuery, query)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseGtidPos parses a GTID position, composed by a comma separated list of domain-server_id-sequence GTIDs,
+// into the sequence number of each replication domain. An empty GTID position results in an empty map.
+func ParseGtidPos(gtid string) (map[uint32]uint64, error) {
+	domains := make(map[uint32]uint64)
+	if gtid == "" {
+		return domains, nil
+	}
+	for _, g := range strings.Split(gtid, ",") {
+		parts := strings.Split(strings.TrimSpace(g), "-")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("GTID '%s' must be in domain-server_id-sequence format", g)
+		}
+		domain, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("GTID '%s' has an invalid domain: %v", g, err)
+		}
+		if _, err := strconv.ParseUint(parts[1], 10, 32); err != nil {
+			return nil, fmt.Errorf("GTID '%s' has an invalid server_id: %v", g, err)
+		}
+		seqno, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("GTID '%s' has an invalid sequence: %v", g, err)
+		}
+		if _, ok := domains[uint32(domain)]; ok {
+			return nil, fmt.Errorf("duplicated domain '%d'", domain)
+		}
+		domains[uint32(domain)] = seqno
+	}
+	return domains, nil
+}
+
+// GtidIsAhead indicates whether the GTID position a is ahead of b, considering every replication domain.
+// a is ahead when it is at least at the same sequence as b in all domains and strictly ahead in at least one of them.
+// Domains missing in one of the positions are considered to be at sequence 0.
+// Diverged positions, where each of them is ahead in a different domain, are not ahead of each other.
+func GtidIsAhead(a, b string) (bool, error) {
+	aDomains, err := ParseGtidPos(a)
+	if err != nil {
+		return false, fmt.Errorf("error parsing GTID '%s': %v", a, err)
+	}
+	bDomains, err := ParseGtidPos(b)
+	if err != nil {
+		return false, fmt.Errorf("error parsing GTID '%s': %v", b, err)
+	}
+
+	ahead := false
+	for domain, bSeqno := range bDomains {
+		aSeqno := aDomains[domain]
+		if aSeqno < bSeqno {
+			return false, nil
+		}
+		if aSeqno > bSeqno {
+			ahead = true
+		}
+	}
+	for domain, aSeqno := range aDomains {
+		if _, ok := bDomains[domain]; !ok && aSeqno > 0 {
+			ahead = true
+		}
+	}
+	return ahead, nil
+}
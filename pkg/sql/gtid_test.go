@@ -0,0 +1,204 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseGtidPos(t *testing.T) {
+	tests := []struct {
+		name        string
+		gtid        string
+		wantDomains map[uint32]uint64
+		wantErr     bool
+	}{
+		{
+			name:        "empty",
+			gtid:        "",
+			wantDomains: map[uint32]uint64{},
+			wantErr:     false,
+		},
+		{
+			name: "single domain",
+			gtid: "0-10-42",
+			wantDomains: map[uint32]uint64{
+				0: 42,
+			},
+			wantErr: false,
+		},
+		{
+			name: "multiple domains",
+			gtid: "0-10-42, 1-20-7,5-10-18446744073709551615",
+			wantDomains: map[uint32]uint64{
+				0: 42,
+				1: 7,
+				5: 18446744073709551615,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "duplicated domain",
+			gtid:        "0-10-42,0-11-40",
+			wantDomains: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "domain out of range",
+			gtid:        "4294967296-10-42",
+			wantDomains: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "missing sequence",
+			gtid:        "0-10",
+			wantDomains: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "invalid sequence",
+			gtid:        "0-10-foo",
+			wantDomains: nil,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domains, err := ParseGtidPos(tt.gtid)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantDomains, domains); diff != "" {
+				t.Errorf("unexpected domains (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGtidIsAhead(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         string
+		b         string
+		wantAhead bool
+		wantErr   bool
+	}{
+		{
+			name:      "equal",
+			a:         "0-10-42,1-20-7",
+			b:         "1-20-7,0-10-42",
+			wantAhead: false,
+			wantErr:   false,
+		},
+		{
+			name:      "both empty",
+			a:         "",
+			b:         "",
+			wantAhead: false,
+			wantErr:   false,
+		},
+		{
+			name:      "ahead of empty",
+			a:         "0-10-1",
+			b:         "",
+			wantAhead: true,
+			wantErr:   false,
+		},
+		{
+			name:      "empty behind",
+			a:         "",
+			b:         "0-10-1",
+			wantAhead: false,
+			wantErr:   false,
+		},
+		{
+			name:      "ahead in single domain",
+			a:         "0-10-43",
+			b:         "0-10-42",
+			wantAhead: true,
+			wantErr:   false,
+		},
+		{
+			name:      "ahead with different server_id",
+			a:         "0-11-43",
+			b:         "0-10-42",
+			wantAhead: true,
+			wantErr:   false,
+		},
+		{
+			name:      "ahead in one domain and equal in the other",
+			a:         "0-10-42,1-20-8",
+			b:         "0-10-42,1-20-7",
+			wantAhead: true,
+			wantErr:   false,
+		},
+		{
+			name:      "ahead in all domains",
+			a:         "0-10-50,1-20-8",
+			b:         "0-10-42,1-20-7",
+			wantAhead: true,
+			wantErr:   false,
+		},
+		{
+			name:      "behind in one domain",
+			a:         "0-10-42,1-20-6",
+			b:         "0-10-42,1-20-7",
+			wantAhead: false,
+			wantErr:   false,
+		},
+		{
+			name:      "diverged",
+			a:         "0-10-50,1-20-6",
+			b:         "0-10-42,1-20-7",
+			wantAhead: false,
+			wantErr:   false,
+		},
+		{
+			name:      "extra domain",
+			a:         "0-10-42,1-20-7",
+			b:         "0-10-42",
+			wantAhead: true,
+			wantErr:   false,
+		},
+		{
+			name:      "missing domain",
+			a:         "0-10-50",
+			b:         "0-10-42,1-20-7",
+			wantAhead: false,
+			wantErr:   false,
+		},
+		{
+			name:      "invalid a",
+			a:         "0-10",
+			b:         "0-10-42",
+			wantAhead: false,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid b",
+			a:         "0-10-42",
+			b:         "0-10-42,0-10-43",
+			wantAhead: false,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ahead, err := GtidIsAhead(tt.a, tt.b)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if ahead != tt.wantAhead {
+				t.Errorf("unexpected ahead, want: %v got: %v", tt.wantAhead, ahead)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package sql
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// InnoDBStatus represents the key fields of the SHOW ENGINE INNODB STATUS output.
+type InnoDBStatus struct {
+	HistoryListLength     int64
+	PendingReads          int64
+	PendingWritesLRU      int64
+	PendingWritesFlush    int64
+	BufferPoolSize        int64
+	FreeBuffers           int64
+	DatabasePages         int64
+	ModifiedDatabasePages int64
+}
+
+var (
+	innoDBHistoryListLengthRegex = regexp.MustCompile(`^History list length\s+(\d+)`)
+	innoDBPendingReadsRegex      = regexp.MustCompile(`^Pending reads\s+(\d+)`)
+	innoDBPendingWritesRegex     = regexp.MustCompile(`^Pending writes: LRU (\d+), flush list (\d+)`)
+	innoDBBufferPoolSizeRegex    = regexp.MustCompile(`^Buffer pool size\s+(\d+)`)
+	innoDBFreeBuffersRegex       = regexp.MustCompile(`^Free buffers\s+(\d+)`)
+	innoDBDatabasePagesRegex     = regexp.MustCompile(`^Database pages\s+(\d+)`)
+	innoDBModifiedPagesRegex     = regexp.MustCompile(`^Modified db pages\s+(\d+)`)
+)
+
+func (c *Client) InnoDBStatus(ctx context.Context) (*InnoDBStatus, error) {
+	row := c.db.QueryRowContext(ctx, "SHOW ENGINE INNODB STATUS;")
+	var engineType, name, status string
+	if err := row.Scan(&engineType, &name, &status); err != nil {
+		return nil, fmt.Errorf("error scanning InnoDB status: %v", err)
+	}
+	return parseInnoDBStatus(status)
+}
+
+func parseInnoDBStatus(status string) (*InnoDBStatus, error) {
+	var innoDBStatus InnoDBStatus
+	fields := []struct {
+		regex *regexp.Regexp
+		dest  []*int64
+	}{
+		{regex: innoDBHistoryListLengthRegex, dest: []*int64{&innoDBStatus.HistoryListLength}},
+		{regex: innoDBPendingReadsRegex, dest: []*int64{&innoDBStatus.PendingReads}},
+		{regex: innoDBPendingWritesRegex, dest: []*int64{&innoDBStatus.PendingWritesLRU, &innoDBStatus.PendingWritesFlush}},
+		{regex: innoDBBufferPoolSizeRegex, dest: []*int64{&innoDBStatus.BufferPoolSize}},
+		{regex: innoDBFreeBuffersRegex, dest: []*int64{&innoDBStatus.FreeBuffers}},
+		{regex: innoDBDatabasePagesRegex, dest: []*int64{&innoDBStatus.DatabasePages}},
+		{regex: innoDBModifiedPagesRegex, dest: []*int64{&innoDBStatus.ModifiedDatabasePages}},
+	}
+	found := make([]bool, len(fields))
+
+	scanner := bufio.NewScanner(strings.NewReader(status))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		for i, field := range fields {
+			// only the first occurrence is considered, as the individual buffer pools are detailed afterwards
+			if found[i] {
+				continue
+			}
+			matches := field.regex.FindStringSubmatch(line)
+			if matches == nil {
+				continue
+			}
+			for j, dest := range field.dest {
+				val, err := strconv.ParseInt(matches[j+1], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing '%s': %v", line, err)
+				}
+				*dest = val
+			}
+			found[i] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading InnoDB status: %v", err)
+	}
+	if !found[0] {
+		return nil, errors.New("history list length not found in InnoDB status")
+	}
+	return &innoDBStatus, nil
+}
+
+// BufferPoolStats represents the InnoDB buffer pool statistics, aggregated across all the buffer pool instances.
+type BufferPoolStats struct {
+	PoolSize              int64
+	FreeBuffers           int64
+	DatabasePages         int64
+	ModifiedDatabasePages int64
+	PendingReads          int64
+}
+
+func (c *Client) BufferPoolStats(ctx context.Context) (*BufferPoolStats, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		"SELECT POOL_SIZE, FREE_BUFFERS, DATABASE_PAGES, MODIFIED_DATABASE_PAGES, PENDING_READS "+
+			"FROM information_schema.INNODB_BUFFER_POOL_STATS;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error querying buffer pool stats: %v", err)
+	}
+	defer rows.Close()
+	return scanBufferPoolStats(rows)
+}
+
+func scanBufferPoolStats(rows rowScanner) (*BufferPoolStats, error) {
+	var stats BufferPoolStats
+	for rows.Next() {
+		var poolSize, freeBuffers, databasePages, modifiedDatabasePages, pendingReads int64
+		if err := rows.Scan(&poolSize, &freeBuffers, &databasePages, &modifiedDatabasePages, &pendingReads); err != nil {
+			return nil, fmt.Errorf("error scanning buffer pool stats: %v", err)
+		}
+		stats.PoolSize += poolSize
+		stats.FreeBuffers += freeBuffers
+		stats.DatabasePages += databasePages
+		stats.ModifiedDatabasePages += modifiedDatabasePages
+		stats.PendingReads += pendingReads
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating buffer pool stats: %v", err)
+	}
+	return &stats, nil
+}
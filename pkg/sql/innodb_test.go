@@ -0,0 +1,242 @@
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const innoDBStatusMariaDB11 = `
+=====================================
+2024-05-10 10:21:13 0x7f5c2c1ff6c0 INNODB MONITOR OUTPUT
+=====================================
+Per second averages calculated from the last 11 seconds
+-----------------
+BACKGROUND THREAD
+-----------------
+srv_master_thread loops: 0 srv_active, 0 srv_shutdown, 1283 srv_idle
+srv_master_thread log flush and writes: 1283
+----------
+SEMAPHORES
+----------
+------------
+TRANSACTIONS
+------------
+Trx id counter 1053
+Purge done for trx's n:o < 1051 undo n:o < 0 state: running
+History list length 27
+LIST OF TRANSACTIONS FOR EACH SESSION:
+---TRANSACTION (0x7f5c4a3fdb80), not started
+0 lock struct(s), heap size 1128, 0 row lock(s)
+--------
+FILE I/O
+--------
+Pending flushes (fsync): 0
+162 OS file reads, 38 OS file writes, 38 OS fsyncs
+0.00 reads/s, 0 avg bytes/read, 0.00 writes/s, 0.00 fsyncs/s
+---
+LOG
+---
+Log sequence number 63294
+Log flushed up to   63294
+Pages flushed up to 50030
+Last checkpoint at  50018
+----------------------
+BUFFER POOL AND MEMORY
+----------------------
+Total large memory allocated 167772160
+Dictionary memory allocated 853672
+Buffer pool size   8112
+Buffer pool size, bytes 134217728
+Free buffers       7820
+Database pages     292
+Old database pages 0
+Modified db pages  4
+Percent of dirty pages(LRU & free pages): 0.049
+Max dirty pages percent: 90.000
+Pending reads 2
+Pending writes: LRU 1, flush list 3
+Pages made young 0, not young 0
+0.00 youngs/s, 0.00 non-youngs/s
+Pages read 150, created 142, written 0
+0.00 reads/s, 0.00 creates/s, 0.00 writes/s
+No buffer pool page gets since the last printout
+LRU len: 292, unzip_LRU len: 0
+I/O sum[0]:cur[0], unzip sum[0]:cur[0]
+--------------
+ROW OPERATIONS
+--------------
+0 read views open inside InnoDB
+state: sleeping
+----------------------------
+END OF INNODB MONITOR OUTPUT
+============================
+`
+
+const innoDBStatusMultipleBufferPools = `
+------------
+TRANSACTIONS
+------------
+Trx id counter 5139
+History list length 1042
+----------------------
+BUFFER POOL AND MEMORY
+----------------------
+Buffer pool size   16384
+Free buffers       1024
+Database pages     15000
+Modified db pages  120
+Pending reads 0
+Pending writes: LRU 0, flush list 0, single page 0
+----------------------
+INDIVIDUAL BUFFER POOL INFO
+----------------------
+---BUFFER POOL 0
+Buffer pool size   8192
+Free buffers       512
+Database pages     7500
+Modified db pages  60
+Pending reads 0
+Pending writes: LRU 0, flush list 0, single page 0
+---BUFFER POOL 1
+Buffer pool size   8192
+Free buffers       512
+Database pages     7500
+Modified db pages  60
+Pending reads 0
+Pending writes: LRU 0, flush list 0, single page 0
+`
+
+func TestParseInnoDBStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		wantStatus *InnoDBStatus
+		wantErr    bool
+	}{
+		{
+			name:       "empty",
+			status:     "",
+			wantStatus: nil,
+			wantErr:    true,
+		},
+		{
+			name:   "MariaDB 11",
+			status: innoDBStatusMariaDB11,
+			wantStatus: &InnoDBStatus{
+				HistoryListLength:     27,
+				PendingReads:          2,
+				PendingWritesLRU:      1,
+				PendingWritesFlush:    3,
+				BufferPoolSize:        8112,
+				FreeBuffers:           7820,
+				DatabasePages:         292,
+				ModifiedDatabasePages: 4,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "multiple buffer pools",
+			status: innoDBStatusMultipleBufferPools,
+			wantStatus: &InnoDBStatus{
+				HistoryListLength:     1042,
+				PendingReads:          0,
+				PendingWritesLRU:      0,
+				PendingWritesFlush:    0,
+				BufferPoolSize:        16384,
+				FreeBuffers:           1024,
+				DatabasePages:         15000,
+				ModifiedDatabasePages: 120,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "invalid number",
+			status:     "History list length 99999999999999999999",
+			wantStatus: nil,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := parseInnoDBStatus(tt.status)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantStatus, status); diff != "" {
+				t.Errorf("unexpected InnoDB status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScanBufferPoolStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      *fakeRows
+		wantStats *BufferPoolStats
+		wantErr   bool
+	}{
+		{
+			name: "single buffer pool",
+			rows: &fakeRows{
+				rows: [][]any{
+					{int64(8112), int64(7820), int64(292), int64(4), int64(0)},
+				},
+			},
+			wantStats: &BufferPoolStats{
+				PoolSize:              8112,
+				FreeBuffers:           7820,
+				DatabasePages:         292,
+				ModifiedDatabasePages: 4,
+				PendingReads:          0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "multiple buffer pools",
+			rows: &fakeRows{
+				rows: [][]any{
+					{int64(8192), int64(512), int64(7500), int64(60), int64(1)},
+					{int64(8192), int64(512), int64(7500), int64(60), int64(2)},
+				},
+			},
+			wantStats: &BufferPoolStats{
+				PoolSize:              16384,
+				FreeBuffers:           1024,
+				DatabasePages:         15000,
+				ModifiedDatabasePages: 120,
+				PendingReads:          3,
+			},
+			wantErr: false,
+		},
+		{
+			name: "rows error",
+			rows: &fakeRows{
+				err: errors.New("connection reset"),
+			},
+			wantStats: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := scanBufferPoolStats(tt.rows)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantStats, stats); diff != "" {
+				t.Errorf("unexpected buffer pool stats (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
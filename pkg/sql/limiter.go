@@ -0,0 +1,85 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// clientSlotTimeout is the maximum time to wait for a client slot. It prevents reconcilers from blocking forever
+// when the slots are held by long-lived clients, as reconcile contexts usually have no deadline.
+const clientSlotTimeout = 30 * time.Second
+
+// mariadbClientLimiter bounds the concurrent clients created via NewClientWithMariaDB, per MariaDB.
+var mariadbClientLimiter = newClientLimiter(0, clientSlotTimeout)
+
+// SetMaxClientsPerMariaDB bounds the number of concurrent clients created via NewClientWithMariaDB for each MariaDB,
+// protecting the server from reaching max_connections under reconcile storms. Zero means unbounded, which is the default.
+// Slots are held until the client is closed, and waiting for a slot times out after clientSlotTimeout. Clients created with
+// WithoutClientLimit, such as the per-Pod clients of a ClientSet, are not bounded.
+// It is meant to be called once at operator startup, before any controller is set up.
+func SetMaxClientsPerMariaDB(limit int) error {
+	if limit < 0 {
+		return fmt.Errorf("invalid max clients %d: it must not be negative", limit)
+	}
+	mariadbClientLimiter = newClientLimiter(limit, clientSlotTimeout)
+	return nil
+}
+
+type clientLimiter struct {
+	limit      int
+	timeout    time.Duration
+	semaphores map[types.NamespacedName]chan struct{}
+	mux        sync.Mutex
+}
+
+func newClientLimiter(limit int, timeout time.Duration) *clientLimiter {
+	return &clientLimiter{
+		limit:      limit,
+		timeout:    timeout,
+		semaphores: make(map[types.NamespacedName]chan struct{}),
+	}
+}
+
+// acquire blocks until a client slot is available for the given key, the timeout is reached or the context is done.
+// The returned release function frees the slot, and it is safe to call it multiple times.
+func (l *clientLimiter) acquire(ctx context.Context, key types.NamespacedName) (func(), error) {
+	if l.limit <= 0 {
+		return func() {}, nil
+	}
+	semaphore := l.semaphore(key)
+
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	select {
+	case semaphore <- struct{}{}:
+		var once sync.Once
+		return func() {
+			once.Do(func() {
+				<-semaphore
+			})
+		}, nil
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("timeout waiting for a client slot for '%s': all %d slots in use", key, l.limit)
+		}
+		return nil, fmt.Errorf("error waiting for a client slot for '%s': %v", key, ctx.Err())
+	}
+}
+
+func (l *clientLimiter) semaphore(key types.NamespacedName) chan struct{} {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	semaphore, ok := l.semaphores[key]
+	if !ok {
+		semaphore = make(chan struct{}, l.limit)
+		l.semaphores[key] = semaphore
+	}
+	return semaphore
+}
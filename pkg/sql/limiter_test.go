@@ -0,0 +1,128 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestClientLimiterBlocksBeyondLimit(t *testing.T) {
+	limiter := newClientLimiter(2, time.Minute)
+	key := types.NamespacedName{Name: "mariadb", Namespace: "default"}
+
+	var releases []func()
+	for i := 0; i < 2; i++ {
+		release, err := limiter.acquire(context.Background(), key)
+		if err != nil {
+			t.Fatalf("unexpected error acquiring slot %d: %v", i, err)
+		}
+		releases = append(releases, release)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx, key); err == nil {
+		t.Fatal("expect error to have occurred acquiring a slot beyond the limit, got nil")
+	}
+
+	acquired := make(chan error, 1)
+	go func() {
+		release, err := limiter.acquire(context.Background(), key)
+		if err == nil {
+			releases = append(releases, release)
+		}
+		acquired <- err
+	}()
+	select {
+	case err := <-acquired:
+		t.Fatalf("expected acquire to block until a slot is released, got: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	releases[0]()
+	// Releasing multiple times must not free additional slots.
+	releases[0]()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("unexpected error acquiring released slot: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected acquire to succeed after a slot is released")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx, key); err == nil {
+		t.Fatal("expect error to have occurred after releasing a slot twice, got nil")
+	}
+	for _, release := range releases[1:] {
+		release()
+	}
+}
+
+func TestClientLimiterPerMariaDB(t *testing.T) {
+	limiter := newClientLimiter(1, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, key := range []types.NamespacedName{
+		{Name: "mariadb", Namespace: "default"},
+		{Name: "mariadb-galera", Namespace: "default"},
+		{Name: "mariadb", Namespace: "test"},
+	} {
+		if _, err := limiter.acquire(ctx, key); err != nil {
+			t.Errorf("unexpected error acquiring slot for '%s': %v", key, err)
+		}
+	}
+}
+
+func TestClientLimiterUnbounded(t *testing.T) {
+	limiter := newClientLimiter(0, time.Minute)
+	key := types.NamespacedName{Name: "mariadb", Namespace: "default"}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for i := 0; i < 100; i++ {
+		if _, err := limiter.acquire(ctx, key); err != nil {
+			t.Fatalf("unexpected error acquiring slot %d: %v", i, err)
+		}
+	}
+}
+
+func TestSetMaxClientsPerMariaDBInvalid(t *testing.T) {
+	if err := SetMaxClientsPerMariaDB(-1); err == nil {
+		t.Error("expect error to have occurred, got nil")
+	}
+	if mariadbClientLimiter.limit != 0 {
+		t.Errorf("expected limit to be unchanged after error, got: %d", mariadbClientLimiter.limit)
+	}
+}
+
+func TestClientLimiterTimeout(t *testing.T) {
+	limiter := newClientLimiter(1, 50*time.Millisecond)
+	key := types.NamespacedName{Name: "mariadb", Namespace: "default"}
+
+	release, err := limiter.acquire(context.Background(), key)
+	if err != nil {
+		t.Fatalf("unexpected error acquiring slot: %v", err)
+	}
+	defer release()
+
+	// Reconcile contexts usually have no deadline, the limiter timeout must be enforced regardless.
+	done := make(chan error, 1)
+	go func() {
+		_, err := limiter.acquire(context.Background(), key)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expect error to have occurred acquiring a slot beyond the limit, got nil")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected acquire to time out")
+	}
+}
@@ -0,0 +1,182 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// privileges is the set of privileges supported by MariaDB.
+// See: https://mariadb.com/kb/en/grant/#privilege-levels.
+var privileges = map[string]struct{}{
+	"ALL":                       {},
+	"ALL PRIVILEGES":            {},
+	"ALTER":                     {},
+	"ALTER ROUTINE":             {},
+	"BINLOG ADMIN":              {},
+	"BINLOG MONITOR":            {},
+	"BINLOG REPLAY":             {},
+	"CONNECTION ADMIN":          {},
+	"CREATE":                    {},
+	"CREATE ROUTINE":            {},
+	"CREATE TABLESPACE":         {},
+	"CREATE TEMPORARY TABLES":   {},
+	"CREATE USER":               {},
+	"CREATE VIEW":               {},
+	"DELETE":                    {},
+	"DELETE HISTORY":            {},
+	"DROP":                      {},
+	"EVENT":                     {},
+	"EXECUTE":                   {},
+	"FEDERATED ADMIN":           {},
+	"FILE":                      {},
+	"GRANT OPTION":              {},
+	"INDEX":                     {},
+	"INSERT":                    {},
+	"LOCK TABLES":               {},
+	"PROCESS":                   {},
+	"PROXY":                     {},
+	"READ_ONLY ADMIN":           {},
+	"REFERENCES":                {},
+	"RELOAD":                    {},
+	"REPLICA MONITOR":           {},
+	"REPLICATION CLIENT":        {},
+	"REPLICATION MASTER ADMIN":  {},
+	"REPLICATION REPLICA":       {},
+	"REPLICATION REPLICA ADMIN": {},
+	"REPLICATION SLAVE":         {},
+	"REPLICATION SLAVE ADMIN":   {},
+	"SELECT":                    {},
+	"SET USER":                  {},
+	"SHOW CREATE ROUTINE":       {},
+	"SHOW DATABASES":            {},
+	"SHOW VIEW":                 {},
+	"SHUTDOWN":                  {},
+	"SLAVE MONITOR":             {},
+	"SUPER":                     {},
+	"TRIGGER":                   {},
+	"UPDATE":                    {},
+	"USAGE":                     {},
+}
+
+// columnPrivileges is the set of privileges that can be granted on specific columns, i.e. SELECT (col1, col2).
+var columnPrivileges = map[string]struct{}{
+	"INSERT":     {},
+	"REFERENCES": {},
+	"SELECT":     {},
+	"UPDATE":     {},
+}
+
+// ValidatePrivileges checks that the privileges are supported by MariaDB, returning the offending privilege otherwise.
+func ValidatePrivileges(privs []string) error {
+	if len(privs) == 0 {
+		return errors.New("at least one privilege must be provided")
+	}
+	for _, priv := range privs {
+		if err := validatePrivilege(priv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderPrivileges renders the privileges to be used in GRANT and REVOKE statements, quoting the columns of column privileges.
+func renderPrivileges(privs []string) ([]string, error) {
+	if err := ValidatePrivileges(privs); err != nil {
+		return nil, err
+	}
+	rendered := make([]string, 0, len(privs))
+	for _, priv := range privs {
+		name, columns, err := parsePrivilege(priv)
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) == 0 {
+			rendered = append(rendered, strings.TrimSpace(priv))
+			continue
+		}
+		quoted := make([]string, len(columns))
+		for i, column := range columns {
+			quoted[i] = Identifier(column)
+		}
+		rendered = append(rendered, fmt.Sprintf("%s (%s)", name, strings.Join(quoted, ",")))
+	}
+	return rendered, nil
+}
+
+func validatePrivilege(priv string) error {
+	name, columns, err := parsePrivilege(priv)
+	if err != nil {
+		return err
+	}
+	if len(columns) > 0 {
+		if _, ok := columnPrivileges[name]; !ok {
+			return fmt.Errorf("privilege '%s' cannot be granted on columns", priv)
+		}
+		return nil
+	}
+	if _, ok := privileges[name]; !ok {
+		return fmt.Errorf("unknown privilege '%s'", priv)
+	}
+	return nil
+}
+
+// parsePrivilege returns the normalized name of a privilege and its unquoted columns, if any, i.e. SELECT (id, `name`).
+func parsePrivilege(priv string) (string, []string, error) {
+	idx := strings.Index(priv, "(")
+	if idx == -1 {
+		return strings.ToUpper(strings.Join(strings.Fields(priv), " ")), nil, nil
+	}
+	name := strings.ToUpper(strings.Join(strings.Fields(priv[:idx]), " "))
+	columnList := strings.TrimSpace(priv[idx+1:])
+	if !strings.HasSuffix(columnList, ")") || strings.TrimSpace(columnList[:len(columnList)-1]) == "" {
+		return "", nil, fmt.Errorf("invalid column list in privilege '%s'", priv)
+	}
+	columnList = columnList[:len(columnList)-1]
+
+	var columns []string
+	for {
+		idx := indexKeyword(columnList, ",")
+		if idx == -1 {
+			break
+		}
+		columns = append(columns, columnList[:idx])
+		columnList = columnList[idx+1:]
+	}
+	columns = append(columns, columnList)
+
+	for i, column := range columns {
+		column, err := parseColumn(column)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid column in privilege '%s': %v", priv, err)
+		}
+		columns[i] = column
+	}
+	return name, columns, nil
+}
+
+// parseColumn returns the unquoted name of a column, which may be quoted with backticks.
+func parseColumn(column string) (string, error) {
+	column = strings.TrimSpace(column)
+	if column == "" {
+		return "", errors.New("column must not be empty")
+	}
+	if len(column) >= 2 && strings.HasPrefix(column, "`") && strings.HasSuffix(column, "`") {
+		unquoted := column[1 : len(column)-1]
+		if strings.Contains(strings.ReplaceAll(unquoted, "``", ""), "`") {
+			return "", fmt.Errorf("column '%s' has unescaped backticks", column)
+		}
+		unquoted = strings.ReplaceAll(unquoted, "``", "`")
+		if unquoted == "" {
+			return "", errors.New("column must not be empty")
+		}
+		return unquoted, nil
+	}
+	for _, r := range column {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '$' {
+			return "", fmt.Errorf("column '%s' must be quoted with backticks", column)
+		}
+	}
+	return column, nil
+}
@@ -0,0 +1,189 @@
+package sql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidatePrivileges(t *testing.T) {
+	tests := []struct {
+		name       string
+		privileges []string
+		wantErr    bool
+		wantToken  string
+	}{
+		{
+			name:       "empty",
+			privileges: nil,
+			wantErr:    true,
+		},
+		{
+			name:       "all",
+			privileges: []string{"ALL"},
+			wantErr:    false,
+		},
+		{
+			name:       "all privileges",
+			privileges: []string{"ALL PRIVILEGES"},
+			wantErr:    false,
+		},
+		{
+			name:       "table privileges",
+			privileges: []string{"SELECT", "INSERT", "UPDATE", "DELETE", "CREATE", "DROP", "INDEX", "ALTER"},
+			wantErr:    false,
+		},
+		{
+			name: "administrative privileges",
+			privileges: []string{
+				"BINLOG ADMIN",
+				"CONNECTION ADMIN",
+				"PROCESS",
+				"READ_ONLY ADMIN",
+				"RELOAD",
+				"REPLICA MONITOR",
+				"REPLICATION CLIENT",
+				"REPLICATION SLAVE ADMIN",
+				"SHOW DATABASES",
+				"SLAVE MONITOR",
+			},
+			wantErr: false,
+		},
+		{
+			name:       "grant option",
+			privileges: []string{"SELECT", "GRANT OPTION"},
+			wantErr:    false,
+		},
+		{
+			name:       "lowercase and extra spaces",
+			privileges: []string{"select", " show  databases "},
+			wantErr:    false,
+		},
+		{
+			name:       "column privileges",
+			privileges: []string{"SELECT (id, name)", "UPDATE(name)"},
+			wantErr:    false,
+		},
+		{
+			name:       "quoted column privileges",
+			privileges: []string{"SELECT (`id`, `first name`, `back``tick`)"},
+			wantErr:    false,
+		},
+		{
+			name:       "proxy",
+			privileges: []string{"PROXY"},
+			wantErr:    false,
+		},
+		{
+			name:       "misspelled",
+			privileges: []string{"SELECT", "SELCT"},
+			wantErr:    true,
+			wantToken:  "SELCT",
+		},
+		{
+			name:       "misspelled multi word",
+			privileges: []string{"SHOW DATABASE"},
+			wantErr:    true,
+			wantToken:  "SHOW DATABASE",
+		},
+		{
+			name:       "column privilege not allowed",
+			privileges: []string{"DELETE (id)"},
+			wantErr:    true,
+			wantToken:  "DELETE (id)",
+		},
+		{
+			name:       "empty column list",
+			privileges: []string{"SELECT ()"},
+			wantErr:    true,
+			wantToken:  "SELECT ()",
+		},
+		{
+			name:       "injection attempt",
+			privileges: []string{"SELECT ON *.* TO 'root'@'%'; --"},
+			wantErr:    true,
+			wantToken:  "SELECT ON *.* TO 'root'@'%'; --",
+		},
+		{
+			name:       "injection attempt in column list",
+			privileges: []string{"SELECT (a) ON *.* TO x; DROP DATABASE y; -- (b)"},
+			wantErr:    true,
+			wantToken:  "DROP DATABASE y",
+		},
+		{
+			name:       "unescaped backtick in column",
+			privileges: []string{"SELECT (`a` ON *.* TO x; -- `)"},
+			wantErr:    true,
+		},
+		{
+			name:       "empty column",
+			privileges: []string{"SELECT (id,)"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePrivileges(tt.privileges)
+			if tt.wantErr && err == nil {
+				t.Fatal("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if tt.wantToken != "" && !strings.Contains(err.Error(), tt.wantToken) {
+				t.Errorf("expect error to contain '%s', got: %v", tt.wantToken, err)
+			}
+		})
+	}
+}
+
+func TestRenderPrivileges(t *testing.T) {
+	tests := []struct {
+		name           string
+		privileges     []string
+		wantPrivileges []string
+		wantErr        bool
+	}{
+		{
+			name:           "privileges",
+			privileges:     []string{"SELECT", " show databases "},
+			wantPrivileges: []string{"SELECT", "show databases"},
+			wantErr:        false,
+		},
+		{
+			name:           "column privileges",
+			privileges:     []string{"select (id, name)", "UPDATE(`first name`)"},
+			wantPrivileges: []string{"SELECT (`id`,`name`)", "UPDATE (`first name`)"},
+			wantErr:        false,
+		},
+		{
+			name:           "escaped backtick",
+			privileges:     []string{"SELECT (`back``tick`)"},
+			wantPrivileges: []string{"SELECT (`back``tick`)"},
+			wantErr:        false,
+		},
+		{
+			name:           "injection attempt in column list",
+			privileges:     []string{"SELECT (a) ON *.* TO x; DROP DATABASE y; -- (b)"},
+			wantPrivileges: nil,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privileges, err := renderPrivileges(tt.privileges)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPrivileges, privileges); diff != "" {
+				t.Errorf("unexpected privileges (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
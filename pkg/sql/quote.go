@@ -0,0 +1,20 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Identifier quotes an identifier (i.e. database, table or column name) with backticks, escaping any embedded backticks
+// so it can be safely used in SQL statements.
+func Identifier(s string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(s, "`", "``"))
+}
+
+// StringLiteral quotes a string literal with single quotes, escaping any embedded single quotes and backslashes
+// so it can be safely used in SQL statements.
+func StringLiteral(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `''`)
+	return fmt.Sprintf("'%s'", s)
+}
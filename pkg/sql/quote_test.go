@@ -0,0 +1,97 @@
+package sql
+
+import "testing"
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		want       string
+	}{
+		{
+			name:       "empty",
+			identifier: "",
+			want:       "``",
+		},
+		{
+			name:       "plain",
+			identifier: "mariadb",
+			want:       "`mariadb`",
+		},
+		{
+			name:       "dashes",
+			identifier: "mariadb-galera",
+			want:       "`mariadb-galera`",
+		},
+		{
+			name:       "single quote",
+			identifier: "o'brien",
+			want:       "`o'brien`",
+		},
+		{
+			name:       "embedded backtick",
+			identifier: "foo`bar",
+			want:       "`foo``bar`",
+		},
+		{
+			name:       "injection attempt",
+			identifier: "foo`; DROP DATABASE `mysql",
+			want:       "`foo``; DROP DATABASE ``mysql`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Identifier(tt.identifier); got != tt.want {
+				t.Errorf("unexpected identifier, want: %s got: %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStringLiteral(t *testing.T) {
+	tests := []struct {
+		name    string
+		literal string
+		want    string
+	}{
+		{
+			name:    "empty",
+			literal: "",
+			want:    "''",
+		},
+		{
+			name:    "plain",
+			literal: "utf8mb4",
+			want:    "'utf8mb4'",
+		},
+		{
+			name:    "backtick",
+			literal: "foo`bar",
+			want:    "'foo`bar'",
+		},
+		{
+			name:    "embedded quote",
+			literal: "o'brien",
+			want:    "'o''brien'",
+		},
+		{
+			name:    "backslash",
+			literal: `foo\`,
+			want:    `'foo\\'`,
+		},
+		{
+			name:    "injection attempt",
+			literal: `foo\'; DROP USER 'root`,
+			want:    `'foo\\''; DROP USER ''root'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StringLiteral(tt.literal); got != tt.want {
+				t.Errorf("unexpected string literal, want: %s got: %s", tt.want, got)
+			}
+		})
+	}
+}
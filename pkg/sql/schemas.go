@@ -0,0 +1,68 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// defaultSystemSchemas are the schemas managed by MariaDB itself.
+var defaultSystemSchemas = []string{"information_schema", "mysql", "performance_schema", "sys"}
+
+// systemSchemas are the schemas excluded from Database reconciliation, indexed by lower case name.
+var systemSchemas = newSystemSchemas(nil)
+
+// SetSystemSchemas extends the default system schemas with additional ones, for instance internal schemas of specific MariaDB versions.
+// It is meant to be called once at operator startup, before any controller is set up.
+func SetSystemSchemas(extra []string) error {
+	for _, schema := range extra {
+		if strings.TrimSpace(schema) == "" {
+			return errors.New("system schema must not be empty")
+		}
+	}
+	systemSchemas = newSystemSchemas(extra)
+	return nil
+}
+
+// IsSystemSchema indicates whether a schema is a system schema, which must not be managed as a Database.
+func IsSystemSchema(schema string) bool {
+	_, ok := systemSchemas[strings.ToLower(strings.TrimSpace(schema))]
+	return ok
+}
+
+func newSystemSchemas(extra []string) map[string]struct{} {
+	schemas := make(map[string]struct{}, len(defaultSystemSchemas)+len(extra))
+	for _, schema := range append(defaultSystemSchemas[:len(defaultSystemSchemas):len(defaultSystemSchemas)], extra...) {
+		schemas[strings.ToLower(strings.TrimSpace(schema))] = struct{}{}
+	}
+	return schemas
+}
+
+// ListDatabases returns the databases sorted by name, excluding the system schemas.
+func (c *Client) ListDatabases(ctx context.Context) ([]string, error) {
+	rows, err := c.db.QueryContext(ctx, "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA ORDER BY SCHEMA_NAME;")
+	if err != nil {
+		return nil, fmt.Errorf("error listing databases: %v", err)
+	}
+	defer rows.Close()
+	return scanDatabases(rows)
+}
+
+func scanDatabases(rows rowScanner) ([]string, error) {
+	var databases []string
+	for rows.Next() {
+		var database string
+		if err := rows.Scan(&database); err != nil {
+			return nil, fmt.Errorf("error scanning database: %v", err)
+		}
+		if IsSystemSchema(database) {
+			continue
+		}
+		databases = append(databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating database rows: %v", err)
+	}
+	return databases, nil
+}
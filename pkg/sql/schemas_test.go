@@ -0,0 +1,147 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsSystemSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		extra      []string
+		schema     string
+		wantSystem bool
+	}{
+		{
+			name:       "mysql",
+			schema:     "mysql",
+			wantSystem: true,
+		},
+		{
+			name:       "upper case information_schema",
+			schema:     "INFORMATION_SCHEMA",
+			wantSystem: true,
+		},
+		{
+			name:       "user database",
+			schema:     "mariadb",
+			wantSystem: false,
+		},
+		{
+			name:       "custom system schema",
+			extra:      []string{"mysql_internal"},
+			schema:     "mysql_internal",
+			wantSystem: true,
+		},
+		{
+			name:       "default system schema with custom ones",
+			extra:      []string{"mysql_internal"},
+			schema:     "performance_schema",
+			wantSystem: true,
+		},
+		{
+			name:       "user database with custom system schemas",
+			extra:      []string{"mysql_internal"},
+			schema:     "mariadb",
+			wantSystem: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setSystemSchemas(t, tt.extra)
+			if system := IsSystemSchema(tt.schema); system != tt.wantSystem {
+				t.Errorf("unexpected system schema, want: %v got: %v", tt.wantSystem, system)
+			}
+		})
+	}
+}
+
+func TestSetSystemSchemasInvalid(t *testing.T) {
+	setSystemSchemas(t, nil)
+	if err := SetSystemSchemas([]string{"mysql_internal", " "}); err == nil {
+		t.Error("expect error to have occurred, got nil")
+	}
+	if IsSystemSchema("mysql_internal") {
+		t.Error("expected system schemas to be unchanged after error")
+	}
+}
+
+func TestScanDatabases(t *testing.T) {
+	rows := [][]any{
+		{"information_schema"},
+		{"mariadb"},
+		{"mysql"},
+		{"mysql_internal"},
+		{"performance_schema"},
+		{"sys"},
+		{"wordpress"},
+	}
+	tests := []struct {
+		name          string
+		extra         []string
+		wantDatabases []string
+	}{
+		{
+			name:          "default system schemas",
+			extra:         nil,
+			wantDatabases: []string{"mariadb", "mysql_internal", "wordpress"},
+		},
+		{
+			name:          "custom system schemas",
+			extra:         []string{"mysql_internal"},
+			wantDatabases: []string{"mariadb", "wordpress"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setSystemSchemas(t, tt.extra)
+			databases, err := scanDatabases(&fakeRows{
+				columns: []string{"SCHEMA_NAME"},
+				rows:    rows,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error scanning databases: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantDatabases, databases); diff != "" {
+				t.Errorf("unexpected databases (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDropSystemSchema(t *testing.T) {
+	setSystemSchemas(t, []string{"mysql_internal"})
+
+	for _, database := range []string{"mysql", "mysql_internal"} {
+		dropper := &fakeDatabaseDropper{}
+		if err := dropDatabase(context.Background(), dropper, database, WithFailIfSystemSchema()); err == nil {
+			t.Errorf("expect error to have occurred dropping '%s', got nil", database)
+		}
+		if len(dropper.queries) > 0 {
+			t.Errorf("unexpected queries dropping '%s': %v", database, dropper.queries)
+		}
+	}
+
+	// System schemas are only guarded when explicitly requested.
+	dropper := &fakeDatabaseDropper{}
+	if err := dropDatabase(context.Background(), dropper, "mysql_internal"); err != nil {
+		t.Errorf("unexpected error dropping 'mysql_internal': %v", err)
+	}
+	if len(dropper.queries) != 1 {
+		t.Errorf("unexpected queries dropping 'mysql_internal': %v", dropper.queries)
+	}
+}
+
+func setSystemSchemas(t *testing.T, extra []string) {
+	t.Helper()
+	if err := SetSystemSchemas(extra); err != nil {
+		t.Fatalf("unexpected error setting system schemas: %v", err)
+	}
+	t.Cleanup(func() {
+		systemSchemas = newSystemSchemas(nil)
+	})
+}
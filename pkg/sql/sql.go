@@ -3,35 +3,55 @@ package sql
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"syscall"
 	"text/template"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/go-multierror"
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/pki"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 var (
-	ErrWaitReplicaTimeout = errors.New("timeout waiting for replica to be synced")
+	ErrWaitReplicaTimeout           = errors.New("timeout waiting for replica to be synced")
+	ErrWaitGaleraClusterSizeTimeout = errors.New("timeout waiting for Galera cluster size")
+	ErrDatabaseNotEmpty             = errors.New("database is not empty")
+	ErrAccessDenied                 = errors.New("access denied")
+	ErrServerIDMismatch             = errors.New("server_id mismatch")
+	ErrUserExists                   = errors.New("user already exists")
 )
 
 type Opts struct {
-	Username string
-	Password string
-	Host     string
-	Port     int32
-	Database string
+	Username     string
+	Password     string
+	Host         string
+	Hosts        []string
+	HostOverride string
+	Port         int32
+	Database     string
 
 	MariadbName  string
 	MaxscaleName string
@@ -42,10 +62,22 @@ type Opts struct {
 	TLSClientCert       []byte
 	TLSClientPrivateKey []byte
 
-	Params  map[string]string
-	Timeout *time.Duration
+	Params             map[string]string
+	Timeout            *time.Duration
+	AdminTimeout       *time.Duration
+	InterpolateParams  bool
+	MultiStatements    bool
+	Location           *time.Location
+	MaxAllowedPacket   *int
+	SessionWaitTimeout *int
+	SkipClientLimit    bool
+
+	CredentialProvider CredentialProvider
 }
 
+// CredentialProvider returns the password to be used when establishing a new connection.
+type CredentialProvider func(ctx context.Context) (string, error)
+
 type Opt func(*Opts)
 
 func WithUsername(username string) Opt {
@@ -66,6 +98,24 @@ func WitHost(host string) Opt {
 	}
 }
 
+// WithHosts sets multiple hosts to connect to, which are tried in order until a connection succeeds.
+// Hosts may specify a port in host:port format, otherwise the port set via WithPort is used.
+func WithHosts(hosts []string) Opt {
+	return func(o *Opts) {
+		o.Hosts = hosts
+	}
+}
+
+// WithHostOverride connects to an explicit host, taking precedence over the hosts set via WitHost and WithHosts regardless
+// of the order of the options. It allows targeting an exact Pod or an external address while keeping the MariaDB credentials
+// and TLS resolution of constructors like NewClientWithMariaDB. The host may specify a port in host:port format.
+// When TLS is enabled, the server certificate must be valid for the overridden host.
+func WithHostOverride(host string) Opt {
+	return func(o *Opts) {
+		o.HostOverride = host
+	}
+}
+
 func WithPort(port int32) Opt {
 	return func(o *Opts) {
 		o.Port = port
@@ -102,9 +152,17 @@ func WithTLSClientCert(clientName string, cert, privateKey []byte) Opt {
 	}
 }
 
+// WithParams merges the given DSN params into the existing ones, overriding the keys that are already set.
 func WithParams(params map[string]string) Opt {
 	return func(o *Opts) {
-		o.Params = params
+		merged := make(map[string]string, len(o.Params)+len(params))
+		for k, v := range o.Params {
+			merged[k] = v
+		}
+		for k, v := range params {
+			merged[k] = v
+		}
+		o.Params = merged
 	}
 }
 
@@ -114,8 +172,74 @@ func WithTimeout(d time.Duration) Opt {
 	}
 }
 
+// WithAdminTimeout sets the time limit for administrative statements, such as locking and unlocking tables.
+func WithAdminTimeout(d time.Duration) Opt {
+	return func(o *Opts) {
+		o.AdminTimeout = &d
+	}
+}
+
+// WithInterpolateParams interpolates the query placeholders client-side, saving a round-trip per query.
+func WithInterpolateParams(interpolate bool) Opt {
+	return func(o *Opts) {
+		o.InterpolateParams = interpolate
+	}
+}
+
+// WithMultiStatements allows sending several statements separated by semicolons in a single query.
+func WithMultiStatements(multiStatements bool) Opt {
+	return func(o *Opts) {
+		o.MultiStatements = multiStatements
+	}
+}
+
+// WithLocation sets the location used to parse DATE and DATETIME values into time.Time.
+func WithLocation(loc *time.Location) Opt {
+	return func(o *Opts) {
+		o.Location = loc
+	}
+}
+
+// WithMaxAllowedPacket sets the maximum size in bytes of the packets sent to the server.
+// When set to 0, the max_allowed_packet system variable of the server is used.
+func WithMaxAllowedPacket(bytes int) Opt {
+	return func(o *Opts) {
+		o.MaxAllowedPacket = &bytes
+	}
+}
+
+// WithSessionWaitTimeout sets the wait_timeout in seconds of every session established by the client, so long-lived
+// connections are not reaped by the server wait_timeout. Idle connections are closed by the pool before the timeout is reached.
+func WithSessionWaitTimeout(seconds int) Opt {
+	return func(o *Opts) {
+		o.SessionWaitTimeout = &seconds
+	}
+}
+
+// WithoutClientLimit exempts the client from the limit set by SetMaxClientsPerMariaDB. It is meant for clients whose number
+// is already bounded, such as the per-Pod clients of a ClientSet, which would otherwise hold slots while waiting for others.
+func WithoutClientLimit() Opt {
+	return func(o *Opts) {
+		o.SkipClientLimit = true
+	}
+}
+
+// WithCredentialProvider resolves the password every time a new connection is established,
+// allowing long-lived clients to pick up password rotations.
+func WithCredentialProvider(provider CredentialProvider) Opt {
+	return func(o *Opts) {
+		o.CredentialProvider = provider
+	}
+}
+
+// defaultAdminTimeout is the default time limit for administrative statements.
+const defaultAdminTimeout = 30 * time.Second
+
 type Client struct {
-	db *sql.DB
+	db           *sql.DB
+	opts         Opts
+	adminTimeout time.Duration
+	release      func()
 }
 
 func NewClient(clientOpts ...Opt) (*Client, error) {
@@ -123,16 +247,21 @@ func NewClient(clientOpts ...Opt) (*Client, error) {
 	for _, setOpt := range clientOpts {
 		setOpt(&opts)
 	}
-	dsn, err := BuildDSN(opts)
+	dsns, err := BuildDSNs(opts)
 	if err != nil {
 		return nil, fmt.Errorf("error building DSN: %v", err)
 	}
-	db, err := Connect(dsn)
+	db, err := connectWithFailover(dsns, connectFn(opts))
 	if err != nil {
 		return nil, err
 	}
+	if opts.SessionWaitTimeout != nil {
+		db.SetConnMaxIdleTime(sessionMaxIdleTime(*opts.SessionWaitTimeout))
+	}
 	return &Client{
-		db: db,
+		db:           db,
+		opts:         opts,
+		adminTimeout: ptr.Deref(opts.AdminTimeout, defaultAdminTimeout),
 	}, nil
 }
 
@@ -145,6 +274,9 @@ func NewClientWithMariaDB(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB,
 	opts := []Opt{
 		WithUsername("root"),
 		WithPassword(password),
+		WithCredentialProvider(func(ctx context.Context) (string, error) {
+			return refResolver.SecretKeyRef(ctx, mariadb.Spec.RootPasswordSecretKeyRef.SecretKeySelector, mariadb.Namespace)
+		}),
 		WitHost(func() string {
 			if mariadb.IsHAEnabled() {
 				return statefulset.ServiceFQDNWithService(
@@ -192,11 +324,101 @@ func NewClientWithMariaDB(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB,
 
 		opts = append(opts, WithTLSClientCert(clientCertSelector.Name, []byte(clientCert), []byte(clientPrivateKey)))
 	}
+	opts = append(opts, clientOpts...)
+
+	var resolvedOpts Opts
+	for _, setOpt := range opts {
+		setOpt(&resolvedOpts)
+	}
+	if resolvedOpts.SkipClientLimit {
+		return NewClient(opts...)
+	}
+
+	release, err := mariadbClientLimiter.acquire(ctx, types.NamespacedName{Name: mariadb.Name, Namespace: mariadb.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewClient(opts...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	client.release = release
+	return client, nil
+}
+
+// NewClientWithMaxScale returns a client connected to the first MaxScale listener, trusting the MaxScale CA bundle when TLS is enabled.
+// MaxScale authenticates clients against the backend servers, therefore the credentials must be provided via options.
+func NewClientWithMaxScale(ctx context.Context, maxscale *mariadbv1alpha1.MaxScale, refResolver *refresolver.RefResolver,
+	clientOpts ...Opt) (*Client, error) {
+	var caCert []byte
+	if maxscale.IsTLSEnabled() {
+		caBundle, err := refResolver.SecretKeyRef(ctx, maxscale.TLSCABundleSecretKeyRef(), maxscale.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error getting CA bundle: %v", err)
+		}
+		caCert = []byte(caBundle)
+	}
+	opts, err := maxscaleOpts(maxscale, caCert)
+	if err != nil {
+		return nil, err
+	}
 
 	opts = append(opts, clientOpts...)
 	return NewClient(opts...)
 }
 
+func maxscaleOpts(maxscale *mariadbv1alpha1.MaxScale, caCert []byte) ([]Opt, error) {
+	port, err := maxscale.DefaultPort()
+	if err != nil {
+		return nil, fmt.Errorf("error getting MaxScale port: %v", err)
+	}
+	opts := []Opt{
+		WitHost(statefulset.ServiceFQDNWithService(maxscale.ObjectMeta, maxscale.ServiceKey().Name)),
+		WithPort(*port),
+	}
+	if maxscale.IsTLSEnabled() {
+		opts = append(opts, WithMaxscaleTLS(maxscale.Name, maxscale.Namespace, caCert))
+	}
+	return opts, nil
+}
+
+// NewReadOnlyClientWithMariaDB returns a client connected to the secondary Service, meant to be used by read-only workloads.
+// It returns an error when the node is writable, i.e. read_only is not enabled, to avoid accidental writes in the primary.
+func NewReadOnlyClientWithMariaDB(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, refResolver *refresolver.RefResolver,
+	clientOpts ...Opt) (*Client, error) {
+	if !mariadb.IsHAEnabled() {
+		return nil, errors.New("secondary Service is only available when HA is enabled")
+	}
+	opts := []Opt{
+		WitHost(statefulset.ServiceFQDNWithService(mariadb.ObjectMeta, mariadb.SecondaryServiceKey().Name)),
+	}
+	opts = append(opts, clientOpts...)
+
+	client, err := NewClientWithMariaDB(ctx, mariadb, refResolver, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureReadOnly(ctx, client.IsSystemVariableEnabled); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+type systemVariableEnabledFn func(ctx context.Context, variable string) (bool, error)
+
+func ensureReadOnly(ctx context.Context, isSystemVariableEnabled systemVariableEnabledFn) error {
+	readOnly, err := isSystemVariableEnabled(ctx, "read_only")
+	if err != nil {
+		return fmt.Errorf("error checking read_only: %v", err)
+	}
+	if !readOnly {
+		return errors.New("node is writable: read_only is not enabled")
+	}
+	return nil
+}
+
 func NewInternalClientWithPodIndex(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, refResolver *refresolver.RefResolver,
 	podIndex int, clientOpts ...Opt) (*Client, error) {
 	opts := []Opt{
@@ -240,13 +462,50 @@ func NewLocalClientWithPodEnv(ctx context.Context, env *environment.PodEnvironme
 	return NewClient(opts...)
 }
 
+// sessionMaxIdleTime returns the time after which idle connections are closed by the pool,
+// leaving a margin to avoid using connections that are about to be reaped by the server wait_timeout.
+func sessionMaxIdleTime(waitTimeoutSeconds int) time.Duration {
+	return time.Duration(waitTimeoutSeconds) * time.Second / 2
+}
+
 func BuildDSN(opts Opts) (string, error) {
-	if opts.Host == "" || opts.Port == 0 {
-		return "", errors.New("invalid opts: host and port are mandatory")
+	dsns, err := BuildDSNs(opts)
+	if err != nil {
+		return "", err
+	}
+	return dsns[0], nil
+}
+
+// redactedPassword replaces the password in redacted DSNs.
+const redactedPassword = "xxxxx"
+
+// RedactDSN masks the password of a DSN, so it can be safely logged. DSNs without password are returned unchanged.
+func RedactDSN(dsn string) string {
+	// The password may contain '@' and '/', the DSN is parsed backwards the same way as the driver does:
+	// [user[:password]@][net[(addr)]]/dbname[?param1=value1&paramN=valueN]
+	slashIdx := strings.LastIndex(dsn, "/")
+	if slashIdx == -1 {
+		return dsn
+	}
+	atIdx := strings.LastIndex(dsn[:slashIdx], "@")
+	if atIdx == -1 {
+		return dsn
+	}
+	colonIdx := strings.Index(dsn[:atIdx], ":")
+	if colonIdx == -1 || colonIdx == atIdx-1 {
+		return dsn
+	}
+	return dsn[:colonIdx+1] + redactedPassword + dsn[atIdx:]
+}
+
+// BuildDSNs builds a DSN for each of the hosts, in the same order they were provided.
+func BuildDSNs(opts Opts) ([]string, error) {
+	addrs, err := addresses(opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid opts: %v", err)
 	}
 	config := mysql.NewConfig()
 	config.Net = "tcp"
-	config.Addr = fmt.Sprintf("%s:%d", opts.Host, opts.Port)
 
 	if opts.Timeout != nil {
 		config.Timeout = *opts.Timeout
@@ -263,16 +522,79 @@ func BuildDSN(opts Opts) (string, error) {
 		config.DBName = opts.Database
 	}
 	if opts.Params != nil {
-		config.Params = opts.Params
+		config.Params = make(map[string]string, len(opts.Params))
+		for k, v := range opts.Params {
+			config.Params[k] = v
+		}
+	}
+	if opts.SessionWaitTimeout != nil {
+		if *opts.SessionWaitTimeout <= 0 {
+			return nil, fmt.Errorf("invalid session wait timeout %d: it must be positive", *opts.SessionWaitTimeout)
+		}
+		if config.Params == nil {
+			config.Params = make(map[string]string)
+		}
+		// Unknown DSN params are set as session system variables every time a new connection is established.
+		config.Params["wait_timeout"] = strconv.Itoa(*opts.SessionWaitTimeout)
+	}
+	config.InterpolateParams = opts.InterpolateParams
+	config.MultiStatements = opts.MultiStatements
+	if opts.Location != nil {
+		config.Loc = opts.Location
+		config.ParseTime = true
+	}
+	if opts.MaxAllowedPacket != nil {
+		if *opts.MaxAllowedPacket < 0 {
+			return nil, fmt.Errorf("invalid max allowed packet %d: it must not be negative", *opts.MaxAllowedPacket)
+		}
+		config.MaxAllowedPacket = *opts.MaxAllowedPacket
 	}
 	if (opts.MariadbName != "" || opts.MaxscaleName != "") && opts.Namespace != "" && opts.TLSCACert != nil {
 		configName, err := configureTLS(opts)
 		if err != nil {
-			return "", fmt.Errorf("error configuring TLS: %v", err)
+			return nil, fmt.Errorf("error configuring TLS: %v", err)
 		}
 		config.TLSConfig = configName
 	}
-	return config.FormatDSN(), nil
+
+	dsns := make([]string, len(addrs))
+	for i, addr := range addrs {
+		config.Addr = addr
+		dsns[i] = config.FormatDSN()
+	}
+	return dsns, nil
+}
+
+func addresses(opts Opts) ([]string, error) {
+	hosts := opts.Hosts
+	if len(hosts) == 0 && opts.Host != "" {
+		hosts = []string{opts.Host}
+	}
+	if opts.HostOverride != "" {
+		hosts = []string{opts.HostOverride}
+	}
+	if len(hosts) == 0 {
+		return nil, errors.New("host and port are mandatory")
+	}
+
+	addrs := make([]string, len(hosts))
+	for i, host := range hosts {
+		if host == "" {
+			return nil, errors.New("host and port are mandatory")
+		}
+		if h, p, err := net.SplitHostPort(host); err == nil {
+			if h == "" || p == "" {
+				return nil, fmt.Errorf("invalid host '%s'", host)
+			}
+			addrs[i] = host
+			continue
+		}
+		if opts.Port == 0 {
+			return nil, errors.New("host and port are mandatory")
+		}
+		addrs[i] = net.JoinHostPort(host, strconv.Itoa(int(opts.Port)))
+	}
+	return addrs, nil
 }
 
 func configureTLS(opts Opts) (string, error) {
@@ -282,12 +604,14 @@ func configureTLS(opts Opts) (string, error) {
 	}
 	var tlsCfg tls.Config
 
-	caBundle := x509.NewCertPool()
-	if ok := caBundle.AppendCertsFromPEM(opts.TLSCACert); ok {
-		tlsCfg.RootCAs = caBundle
-	} else {
-		return "", errors.New("failed parse pem-encoded CA certificates")
+	caBundle, skipped, err := caCertPool(opts.TLSCACert)
+	if err != nil {
+		return "", fmt.Errorf("error parsing CA bundle: %v", err)
 	}
+	if skipped > 0 {
+		log.Log.WithName("sql").Info("Skipped invalid PEM blocks in CA bundle", "config", configName, "skipped", skipped)
+	}
+	tlsCfg.RootCAs = caBundle
 
 	if opts.TLSClientCert != nil && opts.TLSClientPrivateKey != nil {
 		keyPair, err := tls.X509KeyPair(opts.TLSClientCert, opts.TLSClientPrivateKey)
@@ -303,6 +627,35 @@ func configureTLS(opts Opts) (string, error) {
 	return configName, nil
 }
 
+// caCertPool parses a bundle of concatenated PEM certificates, returning a pool with the valid ones and the number of skipped blocks.
+// It only fails when no certificate could be added.
+func caCertPool(pemBytes []byte) (*x509.CertPool, int, error) {
+	pool := x509.NewCertPool()
+	added, skipped := 0, 0
+	for len(pemBytes) > 0 {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" || len(block.Headers) != 0 {
+			skipped++
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			skipped++
+			continue
+		}
+		pool.AddCert(cert)
+		added++
+	}
+	if added == 0 {
+		return nil, skipped, fmt.Errorf("no valid PEM-encoded certificates found, skipped %d blocks", skipped)
+	}
+	return pool, skipped, nil
+}
+
 func configTLSName(opts Opts) (string, error) {
 	var configName string
 	if opts.MariadbName != "" {
@@ -322,24 +675,106 @@ func configTLSName(opts Opts) (string, error) {
 func Connect(dsn string) (*sql.DB, error) {
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error opening '%s': %v", RedactDSN(dsn), err)
 	}
 	if err := db.Ping(); err != nil {
-		return nil, err
+		db.Close()
+		return nil, fmt.Errorf("error connecting to '%s': %v", RedactDSN(dsn), err)
 	}
 	return db, nil
 }
 
+// ConnectWithFailover connects to the first available DSN, trying them in order.
+func ConnectWithFailover(dsns []string) (*sql.DB, error) {
+	return connectWithFailover(dsns, Connect)
+}
+
+func connectWithFailover(dsns []string, connect func(dsn string) (*sql.DB, error)) (*sql.DB, error) {
+	if len(dsns) == 0 {
+		return nil, errors.New("at least one DSN must be provided")
+	}
+	var errs *multierror.Error
+	for i, dsn := range dsns {
+		db, err := connect(dsn)
+		if err == nil {
+			return db, nil
+		}
+		if len(dsns) == 1 {
+			return nil, err
+		}
+		errs = multierror.Append(errs, fmt.Errorf("error connecting to host %d: %v", i, err))
+	}
+	return nil, errs.ErrorOrNil()
+}
+
 func ConnectWithOpts(opts Opts) (*sql.DB, error) {
-	dsn, err := BuildDSN(opts)
+	dsns, err := BuildDSNs(opts)
 	if err != nil {
 		return nil, fmt.Errorf("error building DNS: %v", err)
 	}
-	return Connect(dsn)
+	return connectWithFailover(dsns, connectFn(opts))
+}
+
+// ConnectWithCredentialProvider connects to the DSN, resolving the password via the provider every time a new connection is established.
+func ConnectWithCredentialProvider(dsn string, provider CredentialProvider) (*sql.DB, error) {
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing DSN: %v", err)
+	}
+	if err := config.Apply(mysql.BeforeConnect(credentialProviderHook(provider))); err != nil {
+		return nil, fmt.Errorf("error applying credential provider: %v", err)
+	}
+	connector, err := mysql.NewConnector(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating connector: %v", err)
+	}
+
+	db := sql.OpenDB(connector)
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error connecting to '%s': %v", RedactDSN(dsn), err)
+	}
+	return db, nil
+}
+
+func connectFn(opts Opts) func(dsn string) (*sql.DB, error) {
+	if opts.CredentialProvider == nil {
+		return Connect
+	}
+	return func(dsn string) (*sql.DB, error) {
+		return ConnectWithCredentialProvider(dsn, opts.CredentialProvider)
+	}
+}
+
+func credentialProviderHook(provider CredentialProvider) func(context.Context, *mysql.Config) error {
+	return func(ctx context.Context, config *mysql.Config) error {
+		password, err := provider(ctx)
+		if err != nil {
+			return fmt.Errorf("error getting password from credential provider: %v", err)
+		}
+		config.Passwd = password
+		return nil
+	}
 }
 
+// Close closes the connection pool, releasing the client slot acquired by NewClientWithMariaDB, if any.
 func (c *Client) Close() error {
-	return c.db.Close()
+	err := c.db.Close()
+	if c.release != nil {
+		c.release()
+	}
+	return err
+}
+
+// DB returns the underlying connection pool, which is an escape hatch for running custom queries or using database/sql directly.
+// It shares the configuration of the client, including TLS. Closing it also closes the client.
+func (c *Client) DB() *sql.DB {
+	return c.db
+}
+
+// Ping verifies that the connection to the database is still alive, establishing a new connection if needed.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.db.PingContext(ctx)
 }
 
 func (c *Client) Exec(ctx context.Context, sql string, args ...any) error {
@@ -347,13 +782,115 @@ func (c *Client) Exec(ctx context.Context, sql string, args ...any) error {
 	return err
 }
 
+type execMultiOpts struct {
+	transaction bool
+}
+
+// ExecMultiOpt configures the execution of a batch of statements.
+type ExecMultiOpt func(*execMultiOpts)
+
+// WithTransaction executes the batch of statements within a transaction, stopping and rolling back at the first failure.
+// Bear in mind that DDL statements, such as CREATE USER or GRANT, cause an implicit commit and cannot be rolled back.
+func WithTransaction() ExecMultiOpt {
+	return func(o *execMultiOpts) {
+		o.transaction = true
+	}
+}
+
+// ExecMulti executes a batch of statements in the same connection, returning a multierror with the failed ones.
+// By default, every statement is executed regardless of the failures of the previous ones.
+// Statements containing several queries separated by semicolons require the client to be created WithMultiStatements.
+func (c *Client) ExecMulti(ctx context.Context, statements []string, opts ...ExecMultiOpt) error {
+	var multiOpts execMultiOpts
+	for _, setOpt := range opts {
+		setOpt(&multiOpts)
+	}
+	if multiOpts.transaction {
+		return c.execMultiInTransaction(ctx, statements)
+	}
+
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting connection: %v", err)
+	}
+	defer conn.Close()
+
+	var errBundle *multierror.Error
+	for i, statement := range statements {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			errBundle = multierror.Append(errBundle, fmt.Errorf("error executing statement %d: %v", i, err))
+		}
+	}
+	return errBundle.ErrorOrNil()
+}
+
+// RunWithRole runs fn in a dedicated connection with the given role active, or with no role when it is empty.
+// As roles are bound to the session, the statements must be executed via the connection passed to fn.
+// The role is cleared before returning the connection to the pool, which is discarded if that is not possible.
+func (c *Client) RunWithRole(ctx context.Context, role string, fn func(ctx context.Context, conn *sql.Conn) error) error {
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting connection: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, buildSetRoleQuery(role)); err != nil {
+		return fmt.Errorf("error setting role: %v", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, buildSetRoleQuery("")); err != nil {
+			// avoid returning a connection with an active role to the pool
+			_ = conn.Raw(func(any) error {
+				return driver.ErrBadConn
+			})
+		}
+	}()
+
+	return fn(ctx, conn)
+}
+
+func buildSetRoleQuery(role string) string {
+	if role == "" {
+		return "SET ROLE NONE;"
+	}
+	return fmt.Sprintf("SET ROLE %s;", Identifier(role))
+}
+
+func (c *Client) execMultiInTransaction(ctx context.Context, statements []string) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %v", err)
+	}
+
+	for i, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			var errBundle *multierror.Error
+			errBundle = multierror.Append(errBundle, fmt.Errorf("error executing statement %d: %v", i, err))
+
+			if err := tx.Rollback(); err != nil {
+				errBundle = multierror.Append(errBundle, fmt.Errorf("error rolling back transaction: %v", err))
+			}
+			return errBundle.ErrorOrNil()
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %v", err)
+	}
+	return nil
+}
+
 type CreateUserOpts struct {
 	IdentifiedBy         string
 	IdentifiedByPassword string
 	IdentifiedVia        string
 	IdentifiedViaUsing   string
+	IdentifiedViaAs      string
 	Require              *mariadbv1alpha1.TLSRequirements
+	RequireNone          bool
 	MaxUserConnections   int32
+	NoLock               bool
+	FailIfDiffers        bool
 }
 
 type CreateUserOpt func(*CreateUserOpts)
@@ -382,82 +919,264 @@ func WithIdentifiedViaUsing(viaUsing string) CreateUserOpt {
 	}
 }
 
+// WithIdentifiedViaAs authenticates the user via a plugin with the AS form, for example: IDENTIFIED VIA ed25519 AS 'hash'.
+func WithIdentifiedViaAs(plugin, value string) CreateUserOpt {
+	return func(cuo *CreateUserOpts) {
+		cuo.IdentifiedVia = plugin
+		cuo.IdentifiedViaAs = value
+	}
+}
+
 func WithTLSRequirements(require *mariadbv1alpha1.TLSRequirements) CreateUserOpt {
 	return func(cuo *CreateUserOpts) {
 		cuo.Require = require
 	}
 }
 
+// WithRequireNone clears the TLS requirements of the user with REQUIRE NONE, overriding any other TLS requirements.
+func WithRequireNone() CreateUserOpt {
+	return func(cuo *CreateUserOpts) {
+		cuo.RequireNone = true
+	}
+}
+
 func WithMaxUserConnections(maxConns int32) CreateUserOpt {
 	return func(cuo *CreateUserOpts) {
 		cuo.MaxUserConnections = maxConns
 	}
 }
 
-func (c *Client) CreateUser(ctx context.Context, accountName string, createUserOpts ...CreateUserOpt) error {
+// WithNoLock avoids locking the account when no authentication method nor TLS requirements are provided.
+func WithNoLock() CreateUserOpt {
+	return func(cuo *CreateUserOpts) {
+		cuo.NoLock = true
+	}
+}
+
+// WithFailIfDiffers makes CreateUser return ErrUserExists when the account already exists with a different authentication,
+// resource limits or TLS requirements, instead of silently leaving it unchanged. The caller may decide to use AlterUser then.
+func WithFailIfDiffers() CreateUserOpt {
+	return func(cuo *CreateUserOpts) {
+		cuo.FailIfDiffers = true
+	}
+}
+
+// CreateUser creates an account if it does not exist. By default, when no authentication method nor TLS requirements are provided,
+// the account is created with ACCOUNT LOCK PASSWORD EXPIRE, so it cannot be used until it is altered. See WithNoLock.
+// Existing accounts are left unchanged, see WithFailIfDiffers.
+func (c *Client) CreateUser(ctx context.Context, account Account, createUserOpts ...CreateUserOpt) error {
 	opts := CreateUserOpts{}
 	for _, setOpt := range createUserOpts {
 		setOpt(&opts)
 	}
-
-	query := fmt.Sprintf("CREATE USER IF NOT EXISTS %s ", accountName)
-	if opts.IdentifiedVia != "" {
-		query += fmt.Sprintf("IDENTIFIED VIA %s ", opts.IdentifiedVia)
-		if opts.IdentifiedViaUsing != "" {
-			query += fmt.Sprintf("USING '%s' ", opts.IdentifiedViaUsing)
+	if opts.FailIfDiffers {
+		exists, err := c.UserExists(ctx, account.User, account.Host)
+		if err != nil {
+			return fmt.Errorf("error checking if user exists: %v", err)
+		}
+		if exists {
+			state, err := c.UserState(ctx, account)
+			if err != nil {
+				return fmt.Errorf("error getting user state: %v", err)
+			}
+			if userDiffers(state, &opts) {
+				return fmt.Errorf("%w with a different authentication: %s", ErrUserExists, account.Quoted())
+			}
 		}
-	} else if opts.IdentifiedByPassword != "" {
-		query += fmt.Sprintf("IDENTIFIED BY PASSWORD '%s' ", opts.IdentifiedByPassword)
-	} else if opts.IdentifiedBy != "" {
-		query += fmt.Sprintf("IDENTIFIED BY '%s' ", opts.IdentifiedBy)
 	}
 
-	if require := opts.Require; require != nil {
+	query, err := buildCreateUserQuery(account, createUserOpts...)
+	if err != nil {
+		return fmt.Errorf("error building CREATE USER query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildCreateUserQuery(account Account, createUserOpts ...CreateUserOpt) (string, error) {
+	opts := CreateUserOpts{}
+	for _, setOpt := range createUserOpts {
+		setOpt(&opts)
+	}
+
+	query := fmt.Sprintf("CREATE USER IF NOT EXISTS %s ", account.Quoted())
+	identifiedSubQuery, err := identifiedQuery(&opts)
+	if err != nil {
+		return "", fmt.Errorf("error processing identified subquery: %v", err)
+	}
+	query += identifiedSubQuery
+
+	if opts.RequireNone {
+		query += "REQUIRE NONE "
+	} else if require := opts.Require; require != nil {
 		requireSubQuery, err := requireQuery(require)
 		if err != nil {
-			return fmt.Errorf("error processing require subquery: %v", err)
+			return "", fmt.Errorf("error processing require subquery: %v", err)
 		}
 		query += fmt.Sprintf("%s ", requireSubQuery)
 	}
 
 	query += fmt.Sprintf("WITH MAX_USER_CONNECTIONS %d ", opts.MaxUserConnections)
-	if opts.IdentifiedBy == "" && opts.IdentifiedByPassword == "" && opts.IdentifiedVia == "" && opts.Require == nil {
+	if opts.IdentifiedBy == "" && opts.IdentifiedByPassword == "" && opts.IdentifiedVia == "" && opts.Require == nil && !opts.NoLock {
 		query += "ACCOUNT LOCK PASSWORD EXPIRE "
 	}
 	query += ";"
 
-	return c.Exec(ctx, query)
+	return query, nil
 }
 
-func (c *Client) DropUser(ctx context.Context, accountName string) error {
-	query := fmt.Sprintf("DROP USER IF EXISTS %s;", accountName)
+func (c *Client) DropUser(ctx context.Context, account Account) error {
+	query := fmt.Sprintf("DROP USER IF EXISTS %s;", account.Quoted())
 
 	return c.Exec(ctx, query)
 }
 
-func (c *Client) AlterUser(ctx context.Context, accountName string, createUserOpts ...CreateUserOpt) error {
+// AlterUser alters an existing user. The ALTER USER statement is skipped when the current authentication and
+// resource limits already match the desired ones. Plain text passwords cannot be compared, so they are always applied.
+func (c *Client) AlterUser(ctx context.Context, account Account, createUserOpts ...CreateUserOpt) error {
 	opts := CreateUserOpts{}
 	for _, setOpt := range createUserOpts {
 		setOpt(&opts)
 	}
-
-	query := fmt.Sprintf("ALTER USER %s ", accountName)
-
+	if opts.IdentifiedBy == "" {
+		state, err := c.UserState(ctx, account)
+		if err != nil {
+			return fmt.Errorf("error getting user state: %v", err)
+		}
+		if !alterUserNeeded(state, &opts) {
+			return nil
+		}
+	}
+
+	query, err := buildAlterUserQuery(account, createUserOpts...)
+	if err != nil {
+		return fmt.Errorf("error building ALTER USER query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+// UserState represents the current authentication and resource limits of a user.
+type UserState struct {
+	Plugin               string
+	AuthenticationString string
+	MaxUserConnections   int32
+	SSLType              string
+	X509Issuer           string
+	X509Subject          string
+}
+
+func (c *Client) UserState(ctx context.Context, account Account) (*UserState, error) {
+	row := c.db.QueryRowContext(
+		ctx,
+		"SELECT plugin, authentication_string, max_user_connections, ssl_type, x509_issuer, x509_subject "+
+			"FROM mysql.user WHERE user=? AND host=?;",
+		account.User,
+		account.Host,
+	)
+	var state UserState
+	err := row.Scan(
+		&state.Plugin,
+		&state.AuthenticationString,
+		&state.MaxUserConnections,
+		&state.SSLType,
+		&state.X509Issuer,
+		&state.X509Subject,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func alterUserNeeded(state *UserState, opts *CreateUserOpts) bool {
+	if opts.IdentifiedBy != "" {
+		return true
+	}
 	if opts.IdentifiedVia != "" {
-		query += fmt.Sprintf("IDENTIFIED VIA %s ", opts.IdentifiedVia)
-		if opts.IdentifiedViaUsing != "" {
-			query += fmt.Sprintf("USING '%s' ", opts.IdentifiedViaUsing)
+		authenticationString := opts.IdentifiedViaUsing
+		if opts.IdentifiedViaAs != "" {
+			authenticationString = opts.IdentifiedViaAs
+		}
+		if state.Plugin != opts.IdentifiedVia || state.AuthenticationString != authenticationString {
+			return true
 		}
 	} else if opts.IdentifiedByPassword != "" {
-		query += fmt.Sprintf("IDENTIFIED BY PASSWORD '%s' ", opts.IdentifiedByPassword)
-	} else if opts.IdentifiedBy != "" {
-		query += fmt.Sprintf("IDENTIFIED BY '%s' ", opts.IdentifiedBy)
+		if state.Plugin != "mysql_native_password" || state.AuthenticationString != opts.IdentifiedByPassword {
+			return true
+		}
+	}
+	if state.MaxUserConnections != opts.MaxUserConnections {
+		return true
+	}
+	if opts.RequireNone {
+		if state.SSLType != "" || state.X509Issuer != "" || state.X509Subject != "" {
+			return true
+		}
+	} else if require := opts.Require; require != nil {
+		sslType, issuer, subject := requireState(require)
+		if !strings.EqualFold(state.SSLType, sslType) || state.X509Issuer != issuer || state.X509Subject != subject {
+			return true
+		}
+	}
+	return false
+}
+
+// userDiffers indicates whether an existing user differs from the desired one. Unlike alterUserNeeded, plain text passwords
+// are compared by their mysql_native_password hash, as it is the only way to know whether they match the stored credential.
+func userDiffers(state *UserState, opts *CreateUserOpts) bool {
+	if opts.IdentifiedBy != "" && opts.IdentifiedVia == "" && opts.IdentifiedByPassword == "" {
+		hashedOpts := *opts
+		hashedOpts.IdentifiedBy = ""
+		hashedOpts.IdentifiedByPassword = nativePasswordHash(opts.IdentifiedBy)
+		return alterUserNeeded(state, &hashedOpts)
+	}
+	return alterUserNeeded(state, opts)
+}
+
+// nativePasswordHash returns the mysql_native_password hash of a password, as returned by the PASSWORD() function.
+func nativePasswordHash(password string) string {
+	stage1 := sha1.Sum([]byte(password))
+	stage2 := sha1.Sum(stage1[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(stage2[:]))
+}
+
+func requireState(require *mariadbv1alpha1.TLSRequirements) (sslType, issuer, subject string) {
+	if require.Issuer != nil {
+		issuer = *require.Issuer
+	}
+	if require.Subject != nil {
+		subject = *require.Subject
+	}
+	switch {
+	case issuer != "" || subject != "":
+		return "SPECIFIED", issuer, subject
+	case require.X509 != nil && *require.X509:
+		return "X509", "", ""
+	case require.SSL != nil && *require.SSL:
+		return "ANY", "", ""
+	default:
+		return "", "", ""
+	}
+}
+
+func buildAlterUserQuery(account Account, createUserOpts ...CreateUserOpt) (string, error) {
+	opts := CreateUserOpts{}
+	for _, setOpt := range createUserOpts {
+		setOpt(&opts)
+	}
+
+	query := fmt.Sprintf("ALTER USER %s ", account.Quoted())
+	identifiedSubQuery, err := identifiedQuery(&opts)
+	if err != nil {
+		return "", fmt.Errorf("error processing identified subquery: %v", err)
 	}
+	query += identifiedSubQuery
 
-	if require := opts.Require; require != nil {
+	if opts.RequireNone {
+		query += "REQUIRE NONE "
+	} else if require := opts.Require; require != nil {
 		requireSubQuery, err := requireQuery(require)
 		if err != nil {
-			return fmt.Errorf("error processing require subquery: %v", err)
+			return "", fmt.Errorf("error processing require subquery: %v", err)
 		}
 		query += fmt.Sprintf("%s ", requireSubQuery)
 	}
@@ -466,7 +1185,33 @@ func (c *Client) AlterUser(ctx context.Context, accountName string, createUserOp
 
 	query += ";"
 
-	return c.Exec(ctx, query)
+	return query, nil
+}
+
+// authPluginRegex matches valid authentication plugin names, which are rendered unquoted in IDENTIFIED VIA.
+var authPluginRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+func identifiedQuery(opts *CreateUserOpts) (string, error) {
+	if opts.IdentifiedViaUsing != "" && opts.IdentifiedViaAs != "" {
+		return "", errors.New("USING and AS are mutually exclusive")
+	}
+	var query string
+	if opts.IdentifiedVia != "" {
+		if !authPluginRegex.MatchString(opts.IdentifiedVia) {
+			return "", fmt.Errorf("invalid authentication plugin '%s', only alphanumeric characters and '_' are allowed", opts.IdentifiedVia)
+		}
+		query += fmt.Sprintf("IDENTIFIED VIA %s ", opts.IdentifiedVia)
+		if opts.IdentifiedViaUsing != "" {
+			query += fmt.Sprintf("USING %s ", StringLiteral(opts.IdentifiedViaUsing))
+		} else if opts.IdentifiedViaAs != "" {
+			query += fmt.Sprintf("AS %s ", StringLiteral(opts.IdentifiedViaAs))
+		}
+	} else if opts.IdentifiedByPassword != "" {
+		query += fmt.Sprintf("IDENTIFIED BY PASSWORD %s ", StringLiteral(opts.IdentifiedByPassword))
+	} else if opts.IdentifiedBy != "" {
+		query += fmt.Sprintf("IDENTIFIED BY %s ", StringLiteral(opts.IdentifiedBy))
+	}
+	return query, nil
 }
 
 func (c *Client) UserExists(ctx context.Context, username, host string) (bool, error) {
@@ -478,8 +1223,25 @@ func (c *Client) UserExists(ctx context.Context, username, host string) (bool, e
 	return count > 0, nil
 }
 
+// GrantScope determines the object a Grant applies to, which is rendered in the ON clause.
+type GrantScope string
+
+const (
+	// GrantScopeGlobal applies to all the databases: ON *.*.
+	GrantScopeGlobal GrantScope = "global"
+	// GrantScopeDatabase applies to all the tables of a database: ON `db`.*.
+	GrantScopeDatabase GrantScope = "database"
+	// GrantScopeTable applies to a table: ON `db`.`table`.
+	GrantScopeTable GrantScope = "table"
+	// GrantScopeFunction applies to a stored function: ON FUNCTION `db`.`function`.
+	GrantScopeFunction GrantScope = "function"
+	// GrantScopeProcedure applies to a stored procedure: ON PROCEDURE `db`.`procedure`.
+	GrantScopeProcedure GrantScope = "procedure"
+)
+
 type grantOpts struct {
 	grantOption bool
+	scope       GrantScope
 }
 
 type GrantOption func(*grantOpts)
@@ -490,74 +1252,159 @@ func WithGrantOption() GrantOption {
 	}
 }
 
+// WithGrantScope sets the scope of the Grant. When it is not set, the database and table are rendered as db.table,
+// where any of them can be a wildcard.
+func WithGrantScope(scope GrantScope) GrantOption {
+	return func(o *grantOpts) {
+		o.scope = scope
+	}
+}
+
+// Grant grants privileges on a database object. The object name is passed as table for table and routine scopes.
+// FLUSH PRIVILEGES is not needed, as GRANT updates the in-memory grant tables.
 func (c *Client) Grant(
 	ctx context.Context,
 	privileges []string,
 	database string,
 	table string,
-	accountName string,
+	account Account,
 	opts ...GrantOption,
 ) error {
+	query, err := buildGrantQuery(privileges, database, table, account, opts...)
+	if err != nil {
+		return fmt.Errorf("error building GRANT query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildGrantQuery(privileges []string, database, table string, account Account, opts ...GrantOption) (string, error) {
+	privileges, err := renderPrivileges(privileges)
+	if err != nil {
+		return "", fmt.Errorf("invalid privileges: %v", err)
+	}
 	var grantOpts grantOpts
 	for _, setOpt := range opts {
 		setOpt(&grantOpts)
 	}
+	object, err := grantObject(grantOpts.scope, database, table)
+	if err != nil {
+		return "", err
+	}
 
-	query := fmt.Sprintf("GRANT %s ON %s.%s TO %s ",
+	query := fmt.Sprintf("GRANT %s ON %s TO %s ",
 		strings.Join(privileges, ","),
-		escapeWildcard(database),
-		escapeWildcard(table),
-		accountName,
+		object,
+		account.Quoted(),
 	)
 	if grantOpts.grantOption {
 		query += "WITH GRANT OPTION "
 	}
 	query += ";"
 
-	return c.Exec(ctx, query)
+	return query, nil
 }
 
+// Revoke revokes privileges on a database object. The object name is passed as table for table and routine scopes.
 func (c *Client) Revoke(
 	ctx context.Context,
 	privileges []string,
 	database string,
 	table string,
-	accountName string,
+	account Account,
 	opts ...GrantOption,
 ) error {
+	query, err := buildRevokeQuery(privileges, database, table, account, opts...)
+	if err != nil {
+		return fmt.Errorf("error building REVOKE query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildRevokeQuery(privileges []string, database, table string, account Account, opts ...GrantOption) (string, error) {
+	privileges, err := renderPrivileges(privileges)
+	if err != nil {
+		return "", fmt.Errorf("invalid privileges: %v", err)
+	}
 	var grantOpts grantOpts
 	for _, setOpt := range opts {
 		setOpt(&grantOpts)
 	}
+	object, err := grantObject(grantOpts.scope, database, table)
+	if err != nil {
+		return "", err
+	}
 
 	if grantOpts.grantOption {
 		privileges = append(privileges, "GRANT OPTION")
 	}
-	query := fmt.Sprintf("REVOKE %s ON %s.%s FROM %s",
+	query := fmt.Sprintf("REVOKE %s ON %s FROM %s",
 		strings.Join(privileges, ","),
-		escapeWildcard(database),
-		escapeWildcard(table),
-		accountName,
+		object,
+		account.Quoted(),
 	)
 
-	return c.Exec(ctx, query)
+	return query, nil
+}
+
+// RevokeAll revokes all the privileges of an account, including GRANT OPTION, resetting it to USAGE.
+// The account name supports any of the formats accepted by ParseAccount.
+// FLUSH PRIVILEGES is not needed, as REVOKE updates the in-memory grant tables.
+func (c *Client) RevokeAll(ctx context.Context, accountName string) error {
+	account, err := ParseAccount(accountName)
+	if err != nil {
+		return fmt.Errorf("error parsing account: %v", err)
+	}
+	return c.Exec(ctx, buildRevokeAllQuery(account))
+}
+
+func buildRevokeAllQuery(account Account) string {
+	return fmt.Sprintf("REVOKE ALL PRIVILEGES, GRANT OPTION FROM %s;", account.Quoted())
+}
+
+func grantObject(scope GrantScope, database, table string) (string, error) {
+	isName := func(s string) bool {
+		return s != "" && s != "*"
+	}
+	switch scope {
+	case "":
+		return fmt.Sprintf("%s.%s", escapeWildcard(database), escapeWildcard(table)), nil
+	case GrantScopeGlobal:
+		return "*.*", nil
+	case GrantScopeDatabase:
+		if !isName(database) {
+			return "", fmt.Errorf("database must be provided for %s scope", scope)
+		}
+		return fmt.Sprintf("%s.*", Identifier(database)), nil
+	case GrantScopeTable, GrantScopeFunction, GrantScopeProcedure:
+		if !isName(database) || !isName(table) {
+			return "", fmt.Errorf("database and object name must be provided for %s scope", scope)
+		}
+		object := fmt.Sprintf("%s.%s", Identifier(database), Identifier(table))
+		if scope == GrantScopeTable {
+			return object, nil
+		}
+		return fmt.Sprintf("%s %s", strings.ToUpper(string(scope)), object), nil
+	default:
+		return "", fmt.Errorf("unsupported grant scope '%s'", scope)
+	}
 }
 
 func escapeWildcard(s string) string {
 	if s == "*" {
 		return s
 	}
-	return fmt.Sprintf("`%s`", s)
+	return Identifier(s)
 }
 
 type DatabaseOpts struct {
 	CharacterSet string
 	Collate      string
+	// Comment to be stored along with the database. It requires MariaDB 10.5 or later.
+	Comment string
 }
 
 func (c *Client) CreateDatabase(ctx context.Context, database string, opts DatabaseOpts) error {
-	sql := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = '%s')", database)
-	row := c.db.QueryRowContext(ctx, sql)
+	row := c.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = ?)", database)
 	var dbExists string
 	if err := row.Scan(&dbExists); err != nil {
 		return err
@@ -565,20 +1412,124 @@ func (c *Client) CreateDatabase(ctx context.Context, database string, opts Datab
 	if dbExists == "1" {
 		return nil
 	}
-	query := fmt.Sprintf("CREATE DATABASE `%s` ", database)
+	return c.Exec(ctx, buildCreateDatabaseQuery(database, opts))
+}
+
+func buildCreateDatabaseQuery(database string, opts DatabaseOpts) string {
+	query := fmt.Sprintf("CREATE DATABASE %s ", Identifier(database))
 	if opts.CharacterSet != "" {
-		query += fmt.Sprintf("CHARACTER SET = '%s' ", opts.CharacterSet)
+		query += fmt.Sprintf("CHARACTER SET = %s ", StringLiteral(opts.CharacterSet))
 	}
 	if opts.Collate != "" {
-		query += fmt.Sprintf("COLLATE = '%s' ", opts.Collate)
+		query += fmt.Sprintf("COLLATE = %s ", StringLiteral(opts.Collate))
+	}
+	if opts.Comment != "" {
+		query += fmt.Sprintf("COMMENT = %s ", StringLiteral(opts.Comment))
 	}
 	query += ";"
+	return query
+}
 
-	return c.Exec(ctx, query)
+type dropDatabaseOpts struct {
+	failIfNotEmpty     bool
+	failIfSystemSchema bool
+}
+
+type DropDatabaseOpt func(*dropDatabaseOpts)
+
+// WithFailIfNotEmpty refuses to drop the database when it contains tables, returning ErrDatabaseNotEmpty.
+func WithFailIfNotEmpty() DropDatabaseOpt {
+	return func(o *dropDatabaseOpts) {
+		o.failIfNotEmpty = true
+	}
+}
+
+// WithFailIfSystemSchema refuses to drop the database when it is a system schema, as reported by IsSystemSchema.
+func WithFailIfSystemSchema() DropDatabaseOpt {
+	return func(o *dropDatabaseOpts) {
+		o.failIfSystemSchema = true
+	}
+}
+
+func (c *Client) DropDatabase(ctx context.Context, database string, opts ...DropDatabaseOpt) error {
+	return dropDatabase(ctx, c, database, opts...)
+}
+
+type databaseDropper interface {
+	DatabaseTableCount(ctx context.Context, database string) (int, error)
+	Exec(ctx context.Context, sql string, args ...any) error
 }
 
-func (c *Client) DropDatabase(ctx context.Context, database string) error {
-	return c.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS `%s`;", database))
+func dropDatabase(ctx context.Context, d databaseDropper, database string, opts ...DropDatabaseOpt) error {
+	var dropOpts dropDatabaseOpts
+	for _, setOpt := range opts {
+		setOpt(&dropOpts)
+	}
+	if dropOpts.failIfSystemSchema && IsSystemSchema(database) {
+		return fmt.Errorf("database '%s' is a system schema and cannot be dropped", database)
+	}
+	if dropOpts.failIfNotEmpty {
+		tables, err := d.DatabaseTableCount(ctx, database)
+		if err != nil {
+			return fmt.Errorf("error counting tables: %v", err)
+		}
+		if tables > 0 {
+			return fmt.Errorf("%w: database '%s' contains %d tables", ErrDatabaseNotEmpty, database, tables)
+		}
+	}
+	return d.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s;", Identifier(database)))
+}
+
+func (c *Client) DatabaseTableCount(ctx context.Context, database string) (int, error) {
+	row := c.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM information_schema.TABLES WHERE table_schema = ?;", database)
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (c *Client) DatabaseSizeBytes(ctx context.Context, database string) (int64, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		"SELECT data_length, index_length FROM information_schema.TABLES WHERE table_schema = ?;",
+		database,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error querying database size: %v", err)
+	}
+	defer rows.Close()
+	return scanSizeBytes(rows)
+}
+
+func (c *Client) TableSizeBytes(ctx context.Context, database, table string) (int64, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		"SELECT data_length, index_length FROM information_schema.TABLES WHERE table_schema = ? AND table_name = ?;",
+		database,
+		table,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error querying table size: %v", err)
+	}
+	defer rows.Close()
+	return scanSizeBytes(rows)
+}
+
+func scanSizeBytes(rows rowScanner) (int64, error) {
+	var size int64
+	for rows.Next() {
+		// data_length and index_length are NULL for views
+		var dataLength, indexLength sql.NullInt64
+		if err := rows.Scan(&dataLength, &indexLength); err != nil {
+			return 0, fmt.Errorf("error scanning size: %v", err)
+		}
+		size += dataLength.Int64 + indexLength.Int64
+	}
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating size rows: %v", err)
+	}
+	return size, nil
 }
 
 func (c *Client) SystemVariable(ctx context.Context, variable string) (string, error) {
@@ -600,47 +1551,653 @@ func (c *Client) IsSystemVariableEnabled(ctx context.Context, variable string) (
 	return val == "1" || val == "ON", nil
 }
 
-func (c *Client) SetSystemVariable(ctx context.Context, variable string, value string) error {
-	sql := fmt.Sprintf("SET @@global.%s=%s;", variable, value)
-	return c.Exec(ctx, sql)
+// IsBinlogEnabled reports whether binary logging is enabled, which is required by replication and point-in-time recovery.
+// Unlike IsSystemVariableEnabled, errors querying the variable are returned instead of being reported as disabled.
+func (c *Client) IsBinlogEnabled(ctx context.Context) (bool, error) {
+	return binlogEnabled(ctx, c.globalSystemVariable)
+}
+
+// globalSystemVariable returns the global value of a system variable. Unlike SystemVariable, errors are returned.
+func (c *Client) globalSystemVariable(ctx context.Context, variable string) (string, error) {
+	var val string
+	if err := c.db.QueryRowContext(ctx, fmt.Sprintf("SELECT @@global.%s;", variable)).Scan(&val); err != nil {
+		return "", err
+	}
+	return val, nil
+}
+
+type systemVariableFn func(ctx context.Context, variable string) (string, error)
+
+func binlogEnabled(ctx context.Context, systemVariable systemVariableFn) (bool, error) {
+	val, err := systemVariable(ctx, "log_bin")
+	if err != nil {
+		return false, fmt.Errorf("error getting log_bin: %v", err)
+	}
+	switch strings.ToUpper(val) {
+	case "1", "ON":
+		return true, nil
+	case "0", "OFF":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected log_bin value: '%s'", val)
+	}
+}
+
+// ServerID returns the server_id of the server the client is connected to.
+func (c *Client) ServerID(ctx context.Context) (int, error) {
+	var serverID int
+	if err := c.db.QueryRowContext(ctx, "SELECT @@global.server_id;").Scan(&serverID); err != nil {
+		return 0, fmt.Errorf("error getting server_id: %v", err)
+	}
+	return serverID, nil
+}
+
+// AssertServerID verifies that the client is connected to the server with the expected server_id,
+// returning ErrServerIDMismatch otherwise. It is meant to detect connections to the wrong node, for instance after a failover.
+func (c *Client) AssertServerID(ctx context.Context, expected int) error {
+	return assertServerID(ctx, c.ServerID, expected)
+}
+
+func assertServerID(ctx context.Context, serverID func(context.Context) (int, error), expected int) error {
+	actual, err := serverID(ctx)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return fmt.Errorf("%w: expected %d, got %d", ErrServerIDMismatch, expected, actual)
+	}
+	return nil
+}
+
+func (c *Client) SetSystemVariable(ctx context.Context, variable string, value string) error {
+	sql := fmt.Sprintf("SET @@global.%s=%s;", variable, value)
+	return c.Exec(ctx, sql)
+}
+
+func (c *Client) SetSystemVariables(ctx context.Context, keyVal map[string]string) error {
+	for k, v := range keyVal {
+		if err := c.SetSystemVariable(ctx, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) LockTablesWithReadLock(ctx context.Context) error {
+	adminCtx, cancel := adminContext(ctx, c.adminTimeout)
+	defer cancel()
+	return c.Exec(adminCtx, "FLUSH TABLES WITH READ LOCK;")
+}
+
+func (c *Client) UnlockTables(ctx context.Context) error {
+	adminCtx, cancel := adminContext(ctx, c.adminTimeout)
+	defer cancel()
+	return c.Exec(adminCtx, "UNLOCK TABLES;")
+}
+
+// adminContext derives a context bounded by the admin timeout, so a hung server cannot block administrative statements indefinitely.
+func adminContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = defaultAdminTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func (c *Client) EnableReadOnly(ctx context.Context) error {
+	return c.SetSystemVariable(ctx, "read_only", "1")
+}
+
+func (c *Client) DisableReadOnly(ctx context.Context) error {
+	return c.SetSystemVariable(ctx, "read_only", "0")
+}
+
+// SetEventScheduler starts or stops the event scheduler, which runs the scheduled events.
+// It cannot be changed at runtime when the server was started with event_scheduler=DISABLED.
+func (c *Client) SetEventScheduler(ctx context.Context, enabled bool) error {
+	return setEventScheduler(ctx, c.SetSystemVariable, enabled)
+}
+
+type setSystemVariableFn func(ctx context.Context, variable string, value string) error
+
+func setEventScheduler(ctx context.Context, setSystemVariable setSystemVariableFn, enabled bool) error {
+	value := "OFF"
+	if enabled {
+		value = "ON"
+	}
+	if err := setSystemVariable(ctx, "event_scheduler", value); err != nil {
+		return fmt.Errorf("error setting event_scheduler to %s: %v", value, err)
+	}
+	return nil
+}
+
+// Demote demotes a primary by enabling read_only and waiting for the in-flight writes to finish by acquiring a global read lock.
+// It returns the GTID position of the primary after the demotion, which can be used by the replicas to catch up.
+func (c *Client) Demote(ctx context.Context) (string, error) {
+	// FLUSH TABLES WITH READ LOCK and UNLOCK TABLES need to be executed in the same connection
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting connection: %v", err)
+	}
+	defer conn.Close()
+	return demote(ctx, &connDemoter{conn: conn, adminTimeout: c.adminTimeout})
+}
+
+type demoter interface {
+	EnableReadOnly(ctx context.Context) error
+	LockTablesWithReadLock(ctx context.Context) error
+	UnlockTables(ctx context.Context) error
+	SystemVariable(ctx context.Context, variable string) (string, error)
+}
+
+func demote(ctx context.Context, d demoter) (gtid string, err error) {
+	if err := d.EnableReadOnly(ctx); err != nil {
+		return "", fmt.Errorf("error enabling read_only: %v", err)
+	}
+	if err := d.LockTablesWithReadLock(ctx); err != nil {
+		return "", fmt.Errorf("error locking tables with read lock: %v", err)
+	}
+	defer func() {
+		if unlockErr := d.UnlockTables(ctx); unlockErr != nil && err == nil {
+			gtid = ""
+			err = fmt.Errorf("error unlocking tables: %v", unlockErr)
+		}
+	}()
+
+	gtid, err = d.SystemVariable(ctx, "gtid_binlog_pos")
+	if err != nil {
+		return "", fmt.Errorf("error getting GTID binlog pos: %v", err)
+	}
+	return gtid, nil
+}
+
+type connDemoter struct {
+	conn         *sql.Conn
+	adminTimeout time.Duration
+}
+
+func (d *connDemoter) EnableReadOnly(ctx context.Context) error {
+	_, err := d.conn.ExecContext(ctx, "SET @@global.read_only=1;")
+	return err
+}
+
+func (d *connDemoter) LockTablesWithReadLock(ctx context.Context) error {
+	adminCtx, cancel := adminContext(ctx, d.adminTimeout)
+	defer cancel()
+	_, err := d.conn.ExecContext(adminCtx, "FLUSH TABLES WITH READ LOCK;")
+	return err
+}
+
+func (d *connDemoter) UnlockTables(ctx context.Context) error {
+	adminCtx, cancel := adminContext(ctx, d.adminTimeout)
+	defer cancel()
+	_, err := d.conn.ExecContext(adminCtx, "UNLOCK TABLES;")
+	return err
+}
+
+func (d *connDemoter) SystemVariable(ctx context.Context, variable string) (string, error) {
+	row := d.conn.QueryRowContext(ctx, fmt.Sprintf("SELECT @@global.%s;", variable))
+	var val string
+	if err := row.Scan(&val); err != nil {
+		return "", err
+	}
+	return val, nil
+}
+
+// KillConnection terminates the connection associated with the given connection ID.
+func (c *Client) KillConnection(ctx context.Context, connID int64) error {
+	query, err := buildKillQuery(connID, false)
+	if err != nil {
+		return fmt.Errorf("error building KILL query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+// KillQuery terminates the statement that the given connection ID is executing, leaving the connection intact.
+func (c *Client) KillQuery(ctx context.Context, connID int64) error {
+	query, err := buildKillQuery(connID, true)
+	if err != nil {
+		return fmt.Errorf("error building KILL QUERY query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildKillQuery(connID int64, queryOnly bool) (string, error) {
+	if connID <= 0 {
+		return "", fmt.Errorf("invalid connection ID: %d", connID)
+	}
+	if queryOnly {
+		return fmt.Sprintf("KILL QUERY %d;", connID), nil
+	}
+	return fmt.Sprintf("KILL CONNECTION %d;", connID), nil
+}
+
+// Shutdown shuts down the server. The server closes the connection while shutting down, so the resulting connection errors
+// are considered a successful shutdown as long as the server is no longer reachable afterwards.
+func (c *Client) Shutdown(ctx context.Context) error {
+	return shutdownError(ctx, c.Exec(ctx, "SHUTDOWN;"), c.db.PingContext)
+}
+
+type pingFn func(ctx context.Context) error
+
+func shutdownError(ctx context.Context, err error, ping pingFn) error {
+	if err == nil || isServerShutdownError(err) {
+		return nil
+	}
+	if isConnectionClosedError(err) {
+		// the connection might have failed before SHUTDOWN was sent, the server must be unreachable to confirm the shutdown
+		if pingErr := ping(ctx); pingErr != nil {
+			return nil
+		}
+		return fmt.Errorf("error shutting down: server still reachable after connection error: %v", err)
+	}
+	return fmt.Errorf("error shutting down: %v", err)
+}
+
+func isConnectionClosedError(err error) bool {
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+func isServerShutdownError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	// ER_SERVER_SHUTDOWN: Server shutdown in progress
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1053
+}
+
+// TestAuth verifies that an account is able to authenticate with a password, by establishing a fresh connection against the client hosts.
+// Only the user of the account is taken into account, as the host is matched by the server using the address of the connection.
+// The TLS client certificate of the client is not presented, only the CA is trusted, so the password is the only credential.
+// It returns ErrAccessDenied when the server rejects the credentials.
+func (c *Client) TestAuth(ctx context.Context, accountName, password string) error {
+	return testAuth(ctx, c.opts, accountName, password, pingDSN)
+}
+
+type pingDSNFn func(ctx context.Context, dsn string) error
+
+func testAuth(ctx context.Context, opts Opts, accountName, password string, ping pingDSNFn) error {
+	account, err := ParseAccount(accountName)
+	if err != nil {
+		return fmt.Errorf("error parsing account: %v", err)
+	}
+	authOpts := opts
+	authOpts.Username = account.User
+	authOpts.Password = password
+	authOpts.Database = ""
+	authOpts.CredentialProvider = nil
+	authOpts.ClientName = ""
+	authOpts.TLSClientCert = nil
+	authOpts.TLSClientPrivateKey = nil
+
+	dsns, err := BuildDSNs(authOpts)
+	if err != nil {
+		return fmt.Errorf("error building DSN: %v", err)
+	}
+	var errs *multierror.Error
+	for i, dsn := range dsns {
+		err := ping(ctx, dsn)
+		if err == nil {
+			return nil
+		}
+		if isAccessDeniedError(err) {
+			return ErrAccessDenied
+		}
+		errs = multierror.Append(errs, fmt.Errorf("error connecting to host %d: %v", i, err))
+	}
+	return errs.ErrorOrNil()
+}
+
+func pingDSN(ctx context.Context, dsn string) error {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return fmt.Errorf("error opening '%s': %v", RedactDSN(dsn), err)
+	}
+	defer db.Close()
+	return db.PingContext(ctx)
+}
+
+func isAccessDeniedError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	// ER_ACCESS_DENIED_ERROR: Access denied for user
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1045
+}
+
+func (c *Client) ResetMaster(ctx context.Context) error {
+	return c.Exec(ctx, "RESET MASTER;")
+}
+
+// ResetMasterKeepGtid deletes the binary logs while preserving the GTID state. MariaDB does not support preserving
+// the GTID state in RESET MASTER, so the binlog GTID state is read beforehand and restored afterwards.
+// RESET MASTER is not executed when the GTID state cannot be read, or when it is empty while there are binary logs,
+// as the GTID state would be lost.
+func (c *Client) ResetMasterKeepGtid(ctx context.Context) error {
+	return resetMasterKeepGtid(ctx, c.globalSystemVariable, c.binaryLogCount, c.Exec)
+}
+
+type binaryLogCountFn func(ctx context.Context) (int, error)
+
+type execFn func(ctx context.Context, sql string, args ...any) error
+
+func resetMasterKeepGtid(ctx context.Context, systemVariable systemVariableFn, binaryLogCount binaryLogCountFn, exec execFn) error {
+	gtidState, err := systemVariable(ctx, "gtid_binlog_state")
+	if err != nil {
+		return fmt.Errorf("error getting GTID binlog state: %v", err)
+	}
+	if gtidState == "" {
+		count, err := binaryLogCount(ctx)
+		if err != nil {
+			return fmt.Errorf("error counting binary logs: %v", err)
+		}
+		if count > 0 {
+			return fmt.Errorf("GTID binlog state is empty while there are %d binary logs, refusing to reset master", count)
+		}
+	}
+	for _, query := range buildResetMasterKeepGtidQueries(gtidState) {
+		if err := exec(ctx, query); err != nil {
+			return fmt.Errorf("error executing '%s': %v", query, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) binaryLogCount(ctx context.Context) (int, error) {
+	rows, err := c.db.QueryContext(ctx, "SHOW BINARY LOGS;")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func buildResetMasterKeepGtidQueries(gtidState string) []string {
+	queries := []string{"RESET MASTER;"}
+	if gtidState != "" {
+		queries = append(queries, fmt.Sprintf("SET @@global.gtid_binlog_state=%s;", StringLiteral(gtidState)))
+	}
+	return queries
+}
+
+// FlushBinaryLogs closes the current binary log and opens a new one.
+func (c *Client) FlushBinaryLogs(ctx context.Context) error {
+	return c.Exec(ctx, "FLUSH BINARY LOGS;")
+}
+
+// PurgeBinaryLogsBefore deletes the binary logs older than the given time. The active binary log is never deleted.
+// The time is rendered in UTC, so the statement is executed in a dedicated connection with the UTC session time zone,
+// which is restored afterwards.
+func (c *Client) PurgeBinaryLogsBefore(ctx context.Context, t time.Time) error {
+	query, err := buildPurgeBinaryLogsBeforeQuery(t)
+	if err != nil {
+		return fmt.Errorf("error building PURGE BINARY LOGS query: %v", err)
+	}
+
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting connection: %v", err)
+	}
+	defer conn.Close()
+
+	var timeZone string
+	if err := conn.QueryRowContext(ctx, "SELECT @@session.time_zone;").Scan(&timeZone); err != nil {
+		return fmt.Errorf("error getting session time_zone: %v", err)
+	}
+	if _, err := conn.ExecContext(ctx, "SET @@session.time_zone='+00:00';"); err != nil {
+		return fmt.Errorf("error setting session time_zone: %v", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET @@session.time_zone=%s;", StringLiteral(timeZone))); err != nil {
+			// avoid returning a connection with a different time_zone to the pool
+			_ = conn.Raw(func(any) error {
+				return driver.ErrBadConn
+			})
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("error purging binary logs: %v", err)
+	}
+	return nil
+}
+
+func buildPurgeBinaryLogsBeforeQuery(t time.Time) (string, error) {
+	if t.IsZero() {
+		return "", errors.New("time must be provided")
+	}
+	return fmt.Sprintf("PURGE BINARY LOGS BEFORE %s;", StringLiteral(t.UTC().Format(time.DateTime))), nil
+}
+
+func (c *Client) StartSlave(ctx context.Context, connName string) error {
+	sql := fmt.Sprintf("START SLAVE %s;", StringLiteral(connName))
+	return c.Exec(ctx, sql)
+}
+
+// StartSlaveUntil starts the replication until the given GTID position is reached.
+func (c *Client) StartSlaveUntil(ctx context.Context, connName string, gtid string) error {
+	query, err := buildStartSlaveUntilQuery(connName, gtid)
+	if err != nil {
+		return fmt.Errorf("error building START SLAVE UNTIL query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildStartSlaveUntilQuery(connName string, gtid string) (string, error) {
+	if gtid == "" {
+		return "", errors.New("GTID must be provided")
+	}
+	return fmt.Sprintf("START SLAVE %s UNTIL master_gtid_pos = %s;", StringLiteral(connName), StringLiteral(gtid)), nil
+}
+
+// SlaveHost represents a replica connected to the primary, as reported by SHOW SLAVE HOSTS.
+type SlaveHost struct {
+	// ServerID is the server_id of the replica.
+	ServerID int
+	// Host is the report_host of the replica. It is empty when the replica does not set report_host.
+	Host string
+	// Port is the report_port of the replica.
+	Port int
+}
+
+// SlaveHosts returns the replicas connected to the primary.
+func (c *Client) SlaveHosts(ctx context.Context) ([]SlaveHost, error) {
+	rows, err := c.db.QueryContext(ctx, "SHOW SLAVE HOSTS;")
+	if err != nil {
+		return nil, fmt.Errorf("error getting slave hosts: %v", err)
+	}
+	defer rows.Close()
+	return scanSlaveHosts(rows)
+}
+
+func scanSlaveHosts(rows columnRowScanner) ([]SlaveHost, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("error getting columns: %v", err)
+	}
+	serverIDIdx, hostIdx, portIdx := -1, -1, -1
+	for i, col := range columns {
+		switch {
+		case strings.EqualFold(col, "Server_id"):
+			serverIDIdx = i
+		case strings.EqualFold(col, "Host"):
+			hostIdx = i
+		case strings.EqualFold(col, "Port"):
+			portIdx = i
+		}
+	}
+	if serverIDIdx == -1 || hostIdx == -1 || portIdx == -1 {
+		return nil, errors.New("columns Server_id, Host and Port not found")
+	}
+
+	var hosts []SlaveHost
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("error scanning slave host: %v", err)
+		}
+		serverID, err := strconv.Atoi(values[serverIDIdx].String)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing Server_id: %v", err)
+		}
+		port, err := strconv.Atoi(values[portIdx].String)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing Port: %v", err)
+		}
+		hosts = append(hosts, SlaveHost{
+			ServerID: serverID,
+			Host:     values[hostIdx].String,
+			Port:     port,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating slave hosts: %v", err)
+	}
+	return hosts, nil
+}
+
+func (c *Client) StopAllSlaves(ctx context.Context) error {
+	return c.Exec(ctx, "STOP ALL SLAVES;")
+}
+
+func (c *Client) StopSlaveIOThread(ctx context.Context, connName string) error {
+	return c.Exec(ctx, fmt.Sprintf("STOP SLAVE %s IO_THREAD;", StringLiteral(connName)))
+}
+
+func (c *Client) StopSlaveSQLThread(ctx context.Context, connName string) error {
+	return c.Exec(ctx, fmt.Sprintf("STOP SLAVE %s SQL_THREAD;", StringLiteral(connName)))
+}
+
+// defaultReplicationDrainTimeout is the maximum time to wait for the relay log to be applied when the context has no deadline.
+const defaultReplicationDrainTimeout = 5 * time.Minute
+
+// StopReplicationGracefully stops the replication without leaving events pending to be applied, avoiding relay log corruption.
+// The IO thread is stopped first, then the SQL thread applies the remaining relay log events before being stopped.
+// It returns the GTID position of the replica after stopping the replication.
+func (c *Client) StopReplicationGracefully(ctx context.Context, connName string) (string, error) {
+	timeout, err := replicationDrainTimeout(ctx, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return stopReplicationGracefully(ctx, c, connName, timeout)
 }
 
-func (c *Client) SetSystemVariables(ctx context.Context, keyVal map[string]string) error {
-	for k, v := range keyVal {
-		if err := c.SetSystemVariable(ctx, k, v); err != nil {
-			return err
+// replicationDrainTimeout returns the time left until the context deadline, failing when it has already passed.
+func replicationDrainTimeout(ctx context.Context, now time.Time) (time.Duration, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultReplicationDrainTimeout, nil
+	}
+	timeout := deadline.Sub(now)
+	if timeout <= 0 {
+		if err := ctx.Err(); err != nil {
+			return 0, err
 		}
+		return 0, context.DeadlineExceeded
 	}
-	return nil
+	return timeout, nil
 }
 
-func (c *Client) LockTablesWithReadLock(ctx context.Context) error {
-	return c.Exec(ctx, "FLUSH TABLES WITH READ LOCK;")
+type replicationStopper interface {
+	StopSlaveIOThread(ctx context.Context, connName string) error
+	StopSlaveSQLThread(ctx context.Context, connName string) error
+	WaitForReplicaGtid(ctx context.Context, gtid string, timeout time.Duration) error
+	relayLogGtidPos(ctx context.Context, connName string) (string, error)
+	gtidSlavePos(ctx context.Context) (string, error)
 }
 
-func (c *Client) UnlockTables(ctx context.Context) error {
-	return c.Exec(ctx, "UNLOCK TABLES;")
-}
+func stopReplicationGracefully(ctx context.Context, s replicationStopper, connName string, timeout time.Duration) (string, error) {
+	if err := s.StopSlaveIOThread(ctx, connName); err != nil {
+		return "", fmt.Errorf("error stopping IO thread: %v", err)
+	}
+	relayLogGtid, err := s.relayLogGtidPos(ctx, connName)
+	if err != nil {
+		return "", fmt.Errorf("error getting relay log GTID position: %v", err)
+	}
+	if relayLogGtid != "" {
+		if err := s.WaitForReplicaGtid(ctx, relayLogGtid, timeout); err != nil {
+			return "", fmt.Errorf("error waiting for relay log to be applied: %v", err)
+		}
+	}
+	if err := s.StopSlaveSQLThread(ctx, connName); err != nil {
+		return "", fmt.Errorf("error stopping SQL thread: %v", err)
+	}
 
-func (c *Client) EnableReadOnly(ctx context.Context) error {
-	return c.SetSystemVariable(ctx, "read_only", "1")
+	gtid, err := s.gtidSlavePos(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting GTID slave pos: %v", err)
+	}
+	return gtid, nil
 }
 
-func (c *Client) DisableReadOnly(ctx context.Context) error {
-	return c.SetSystemVariable(ctx, "read_only", "0")
+// relayLogGtidPos returns the GTID position received by the IO thread, which is pending to be applied by the SQL thread.
+// It returns an empty position when the SQL thread is not running, as the relay log will not be applied.
+func (c *Client) relayLogGtidPos(ctx context.Context, connName string) (string, error) {
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf("SHOW SLAVE %s STATUS;", StringLiteral(connName)))
+	if err != nil {
+		return "", fmt.Errorf("error getting replica status: %v", err)
+	}
+	defer rows.Close()
+	return scanRelayLogGtidPos(rows)
 }
 
-func (c *Client) ResetMaster(ctx context.Context) error {
-	return c.Exec(ctx, "RESET MASTER;")
-}
+func scanRelayLogGtidPos(rows columnRowScanner) (string, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", fmt.Errorf("error getting columns: %v", err)
+	}
+	sqlRunningIdx, gtidIdx := -1, -1
+	for i, col := range columns {
+		if strings.EqualFold(col, "Slave_SQL_Running") {
+			sqlRunningIdx = i
+		}
+		if strings.EqualFold(col, "Gtid_IO_Pos") {
+			gtidIdx = i
+		}
+	}
+	if sqlRunningIdx == -1 || gtidIdx == -1 {
+		return "", errors.New("columns Slave_SQL_Running and Gtid_IO_Pos not found")
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", fmt.Errorf("error getting replica status: %v", err)
+		}
+		return "", errors.New("replica status not found")
+	}
 
-func (c *Client) StartSlave(ctx context.Context, connName string) error {
-	sql := fmt.Sprintf("START SLAVE '%s';", connName)
-	return c.Exec(ctx, sql)
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return "", fmt.Errorf("error scanning replica status: %v", err)
+	}
+	if values[sqlRunningIdx].String != "Yes" {
+		return "", nil
+	}
+	return values[gtidIdx].String, nil
 }
 
-func (c *Client) StopAllSlaves(ctx context.Context) error {
-	return c.Exec(ctx, "STOP ALL SLAVES;")
+func (c *Client) gtidSlavePos(ctx context.Context) (string, error) {
+	var gtid string
+	if err := c.db.QueryRowContext(ctx, "SELECT @@global.gtid_slave_pos;").Scan(&gtid); err != nil {
+		return "", err
+	}
+	return gtid, nil
 }
 
 func (c *Client) ResetAllSlaves(ctx context.Context) error {
@@ -648,8 +2205,7 @@ func (c *Client) ResetAllSlaves(ctx context.Context) error {
 }
 
 func (c *Client) WaitForReplicaGtid(ctx context.Context, gtid string, timeout time.Duration) error {
-	sql := fmt.Sprintf("SELECT MASTER_GTID_WAIT('%s', %d);", gtid, int(timeout.Seconds()))
-	row := c.db.QueryRowContext(ctx, sql)
+	row := c.db.QueryRowContext(ctx, buildWaitForReplicaGtidQuery(gtid, timeout))
 
 	var result int
 	if err := row.Scan(&result); err != nil {
@@ -666,6 +2222,108 @@ func (c *Client) WaitForReplicaGtid(ctx context.Context, gtid string, timeout ti
 	}
 }
 
+// buildWaitForReplicaGtidQuery rounds the timeout up to whole seconds, with a minimum of one second.
+// MASTER_GTID_WAIT returns immediately with a zero timeout and waits forever with a negative one.
+func buildWaitForReplicaGtidQuery(gtid string, timeout time.Duration) string {
+	seconds := max(int64((timeout+time.Second-1)/time.Second), 1)
+	return fmt.Sprintf("SELECT MASTER_GTID_WAIT('%s', %d);", gtid, seconds)
+}
+
+// ReplicaLag returns the replication lag of a replica, based on Seconds_Behind_Master.
+// It returns nil when the lag is unknown, i.e. the replication is not running.
+func (c *Client) ReplicaLag(ctx context.Context, connName string) (*time.Duration, error) {
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf("SHOW SLAVE %s STATUS;", StringLiteral(connName)))
+	if err != nil {
+		return nil, fmt.Errorf("error getting replica status: %v", err)
+	}
+	defer rows.Close()
+	return scanReplicaLag(rows)
+}
+
+type columnRowScanner interface {
+	rowScanner
+	Columns() ([]string, error)
+}
+
+func scanReplicaLag(rows columnRowScanner) (*time.Duration, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("error getting columns: %v", err)
+	}
+	lagIdx := -1
+	for i, col := range columns {
+		if strings.EqualFold(col, "Seconds_Behind_Master") {
+			lagIdx = i
+		}
+	}
+	if lagIdx == -1 {
+		return nil, errors.New("column Seconds_Behind_Master not found")
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("error getting replica status: %v", err)
+		}
+		return nil, errors.New("replica status not found")
+	}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, fmt.Errorf("error scanning replica status: %v", err)
+	}
+	lag := values[lagIdx]
+	if !lag.Valid {
+		return nil, nil
+	}
+	seconds, err := strconv.Atoi(lag.String)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Seconds_Behind_Master: %v", err)
+	}
+	return ptr.To(time.Duration(seconds) * time.Second), nil
+}
+
+// WaitForReplicaLag polls the replication lag until it is within maxLag, returning ErrWaitReplicaTimeout after timeout.
+func (c *Client) WaitForReplicaLag(ctx context.Context, connName string, maxLag time.Duration, timeout time.Duration) error {
+	replicaLag := func(ctx context.Context) (*time.Duration, error) {
+		return c.ReplicaLag(ctx, connName)
+	}
+	return waitForReplicaLag(ctx, replicaLag, maxLag, timeout, 1*time.Second)
+}
+
+func waitForReplicaLag(ctx context.Context, replicaLag func(context.Context) (*time.Duration, error), maxLag time.Duration,
+	timeout time.Duration, interval time.Duration) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		lag, err := replicaLag(timeoutCtx)
+		if err != nil {
+			if timeoutCtx.Err() != nil && ctx.Err() == nil {
+				return ErrWaitReplicaTimeout
+			}
+			return fmt.Errorf("error getting replica lag: %v", err)
+		}
+		if lag != nil && *lag <= maxLag {
+			return nil
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return ErrWaitReplicaTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
 type ChangeMasterOpts struct {
 	Connection string
 	Host       string
@@ -679,6 +2337,9 @@ type ChangeMasterOpts struct {
 	SSLCertPath string
 	SSLKeyPath  string
 	SSLCAPath   string
+	SSLCipher   string
+
+	IgnoreServerIds []int
 }
 
 type ChangeMasterOpt func(*ChangeMasterOpts)
@@ -729,6 +2390,18 @@ func WithChangeMasterSSL(certPath, keyPath, caPath string) ChangeMasterOpt {
 	}
 }
 
+func WithChangeMasterSSLCipher(cipher string) ChangeMasterOpt {
+	return func(cmo *ChangeMasterOpts) {
+		cmo.SSLCipher = cipher
+	}
+}
+
+func WithChangeMasterIgnoreServerIds(serverIds []int) ChangeMasterOpt {
+	return func(cmo *ChangeMasterOpts) {
+		cmo.IgnoreServerIds = serverIds
+	}
+}
+
 func (c *Client) ChangeMaster(ctx context.Context, changeMasterOpts ...ChangeMasterOpt) error {
 	query, err := buildChangeMasterQuery(changeMasterOpts...)
 	if err != nil {
@@ -756,6 +2429,16 @@ func buildChangeMasterQuery(changeMasterOpts ...ChangeMasterOpt) (string, error)
 	if opts.SSLEnabled && (opts.SSLCertPath == "" || opts.SSLKeyPath == "" || opts.SSLCAPath == "") {
 		return "", errors.New("all SSL paths must be provided when SSL is enabled")
 	}
+	if opts.SSLCipher != "" && !opts.SSLEnabled {
+		return "", errors.New("SSL must be enabled in order to set the SSL cipher")
+	}
+	ignoreServerIds := make([]string, len(opts.IgnoreServerIds))
+	for i, id := range opts.IgnoreServerIds {
+		if id < 0 {
+			return "", fmt.Errorf("invalid server ID to ignore: %d", id)
+		}
+		ignoreServerIds[i] = strconv.Itoa(id)
+	}
 
 	tpl := createTpl("change-master.sql", `CHANGE MASTER '{{ .Connection }}' TO
 MASTER_HOST='{{ .Host }}',
@@ -763,17 +2446,31 @@ MASTER_PORT={{ .Port }},
 MASTER_USER='{{ .User }}',
 MASTER_PASSWORD='{{ .Password }}',
 MASTER_USE_GTID={{ .Gtid }},
-MASTER_CONNECT_RETRY={{ .Retries }}{{ if .SSLEnabled }},{{ else }};{{ end }}
-{{- if .SSLEnabled }}
+MASTER_CONNECT_RETRY={{ .Retries }}
+{{- if .IgnoreServerIds }},
+IGNORE_SERVER_IDS=({{ .IgnoreServerIds }})
+{{- end }}
+{{- if .SSLEnabled }},
 MASTER_SSL=1,
 MASTER_SSL_CERT='{{ .SSLCertPath }}',
 MASTER_SSL_KEY='{{ .SSLKeyPath }}',
 MASTER_SSL_CA='{{ .SSLCAPath }}',
-MASTER_SSL_VERIFY_SERVER_CERT=1;
+{{- if .SSLCipher }}
+MASTER_SSL_CIPHER={{ .SSLCipherLiteral }},
 {{- end }}
+MASTER_SSL_VERIFY_SERVER_CERT=1
+{{- end }};
 `)
 	buf := new(bytes.Buffer)
-	err := tpl.Execute(buf, opts)
+	err := tpl.Execute(buf, struct {
+		ChangeMasterOpts
+		IgnoreServerIds  string
+		SSLCipherLiteral string
+	}{
+		ChangeMasterOpts: opts,
+		IgnoreServerIds:  strings.Join(ignoreServerIds, ","),
+		SSLCipherLiteral: StringLiteral(opts.SSLCipher),
+	})
 	if err != nil {
 		return "", fmt.Errorf("error rendering CHANGE MASTER template: %v", err)
 	}
@@ -781,8 +2478,32 @@ MASTER_SSL_VERIFY_SERVER_CERT=1;
 }
 
 func (c *Client) ResetSlavePos(ctx context.Context) error {
-	sql := fmt.Sprintf("SET @@global.%s='';", "gtid_slave_pos")
-	return c.Exec(ctx, sql)
+	return c.SetGtidSlavePos(ctx, "")
+}
+
+// SetGtidSlavePos sets the GTID position where the replicas will start replicating from. An empty GTID resets the position.
+func (c *Client) SetGtidSlavePos(ctx context.Context, gtid string) error {
+	query, err := buildSetGtidSlavePosQuery(gtid)
+	if err != nil {
+		return fmt.Errorf("error building gtid_slave_pos query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildSetGtidSlavePosQuery(gtid string) (string, error) {
+	if err := validateGtidPos(gtid); err != nil {
+		return "", fmt.Errorf("invalid GTID '%s': %v", gtid, err)
+	}
+	return fmt.Sprintf("SET @@global.gtid_slave_pos=%s;", StringLiteral(gtid)), nil
+}
+
+// validateGtidPos validates a GTID position, composed by a comma separated list of domain-server_id-sequence GTIDs.
+func validateGtidPos(gtid string) error {
+	if gtid == "" {
+		return nil
+	}
+	_, err := ParseGtidPos(gtid)
+	return err
 }
 
 const statusVariableSql = "SELECT variable_value FROM information_schema.global_status WHERE variable_name=?;"
@@ -805,10 +2526,85 @@ func (c *Client) StatusVariableInt(ctx context.Context, variable string) (int, e
 	return val, nil
 }
 
+type statusVariableIntFn func(ctx context.Context, variable string) (int, error)
+
+func (c *Client) Uptime(ctx context.Context) (time.Duration, error) {
+	return uptime(ctx, c.StatusVariableInt)
+}
+
+func (c *Client) ThreadsConnected(ctx context.Context) (int, error) {
+	return threadsConnected(ctx, c.StatusVariableInt)
+}
+
+func (c *Client) ThreadsRunning(ctx context.Context) (int, error) {
+	return threadsRunning(ctx, c.StatusVariableInt)
+}
+
+func uptime(ctx context.Context, statusVariableInt statusVariableIntFn) (time.Duration, error) {
+	seconds, err := statusVariableInt(ctx, "uptime")
+	if err != nil {
+		return 0, fmt.Errorf("error getting uptime: %v", err)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+func threadsConnected(ctx context.Context, statusVariableInt statusVariableIntFn) (int, error) {
+	threads, err := statusVariableInt(ctx, "threads_connected")
+	if err != nil {
+		return 0, fmt.Errorf("error getting connected threads: %v", err)
+	}
+	return threads, nil
+}
+
+func threadsRunning(ctx context.Context, statusVariableInt statusVariableIntFn) (int, error) {
+	threads, err := statusVariableInt(ctx, "threads_running")
+	if err != nil {
+		return 0, fmt.Errorf("error getting running threads: %v", err)
+	}
+	return threads, nil
+}
+
 func (c *Client) GaleraClusterSize(ctx context.Context) (int, error) {
 	return c.StatusVariableInt(ctx, "wsrep_cluster_size")
 }
 
+// WaitForGaleraClusterSize polls the Galera cluster size until it reaches the expected size,
+// returning ErrWaitGaleraClusterSizeTimeout after timeout.
+func (c *Client) WaitForGaleraClusterSize(ctx context.Context, expected int, timeout time.Duration) error {
+	return waitForGaleraClusterSize(ctx, c.GaleraClusterSize, expected, timeout, 1*time.Second)
+}
+
+func waitForGaleraClusterSize(ctx context.Context, clusterSize func(context.Context) (int, error), expected int,
+	timeout time.Duration, interval time.Duration) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		size, err := clusterSize(timeoutCtx)
+		if err != nil {
+			if timeoutCtx.Err() != nil && ctx.Err() == nil {
+				return ErrWaitGaleraClusterSizeTimeout
+			}
+			return fmt.Errorf("error getting Galera cluster size: %v", err)
+		}
+		if size >= expected {
+			return nil
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return ErrWaitGaleraClusterSizeTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
 func (c *Client) GaleraClusterStatus(ctx context.Context) (string, error) {
 	return c.StatusVariable(ctx, "wsrep_cluster_status")
 }
@@ -817,6 +2613,192 @@ func (c *Client) GaleraLocalState(ctx context.Context) (string, error) {
 	return c.StatusVariable(ctx, "wsrep_local_state_comment")
 }
 
+// GaleraNodeState is the numeric state of a Galera node, as reported by wsrep_local_state.
+type GaleraNodeState int
+
+const (
+	GaleraNodeStateInitialized GaleraNodeState = 0
+	GaleraNodeStateJoining     GaleraNodeState = 1
+	GaleraNodeStateDonor       GaleraNodeState = 2
+	GaleraNodeStateJoined      GaleraNodeState = 3
+	GaleraNodeStateSynced      GaleraNodeState = 4
+)
+
+// String returns the state as reported by wsrep_local_state_comment.
+func (s GaleraNodeState) String() string {
+	switch s {
+	case GaleraNodeStateInitialized:
+		return "Initialized"
+	case GaleraNodeStateJoining:
+		return "Joining"
+	case GaleraNodeStateDonor:
+		return "Donor/Desynced"
+	case GaleraNodeStateJoined:
+		return "Joined"
+	case GaleraNodeStateSynced:
+		return "Synced"
+	default:
+		return fmt.Sprintf("Unknown(%d)", int(s))
+	}
+}
+
+// GaleraLocalStateNumber returns the numeric state of the node. Unlike GaleraLocalState, it does not depend on the wording of the comment.
+func (c *Client) GaleraLocalStateNumber(ctx context.Context) (GaleraNodeState, error) {
+	return galeraLocalStateNumber(ctx, c.StatusVariableInt)
+}
+
+func galeraLocalStateNumber(ctx context.Context, statusVariableInt statusVariableIntFn) (GaleraNodeState, error) {
+	state, err := statusVariableInt(ctx, "wsrep_local_state")
+	if err != nil {
+		return 0, fmt.Errorf("error getting local state: %v", err)
+	}
+	if state < int(GaleraNodeStateInitialized) || state > int(GaleraNodeStateSynced) {
+		return 0, fmt.Errorf("unknown local state %d", state)
+	}
+	return GaleraNodeState(state), nil
+}
+
+// GaleraMembers returns the addresses of the cluster members accepting client connections, as reported by wsrep_incoming_addresses.
+// It returns an error when the node is not part of the Primary component, as the membership of a partitioned node is not reliable.
+func (c *Client) GaleraMembers(ctx context.Context) ([]string, error) {
+	return galeraMembers(ctx, c.StatusVariable)
+}
+
+type statusVariableFn func(ctx context.Context, variable string) (string, error)
+
+func galeraMembers(ctx context.Context, statusVariable statusVariableFn) ([]string, error) {
+	status, err := statusVariable(ctx, "wsrep_cluster_status")
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster status: %v", err)
+	}
+	if !strings.EqualFold(status, "Primary") {
+		return nil, fmt.Errorf("node is not part of the Primary component: cluster status is '%s'", status)
+	}
+	addresses, err := statusVariable(ctx, "wsrep_incoming_addresses")
+	if err != nil {
+		return nil, fmt.Errorf("error getting incoming addresses: %v", err)
+	}
+	return parseGaleraMembers(addresses), nil
+}
+
+// parseGaleraMembers splits a wsrep_incoming_addresses value into member addresses.
+// Members that have not yet published their address are reported as empty entries, which are skipped.
+func parseGaleraMembers(addresses string) []string {
+	var members []string
+	for _, addr := range strings.Split(addresses, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" || strings.EqualFold(addr, "AUTO") {
+			continue
+		}
+		members = append(members, addr)
+	}
+	return members
+}
+
+type galeraDonorOpts struct {
+	fallback bool
+}
+
+// GaleraDonorOpt configures the SST donor preference.
+type GaleraDonorOpt func(*galeraDonorOpts)
+
+// WithGaleraDonorFallback allows falling back to any other node when none of the preferred donors are available.
+func WithGaleraDonorFallback() GaleraDonorOpt {
+	return func(o *galeraDonorOpts) {
+		o.fallback = true
+	}
+}
+
+// SetGaleraDonor sets the preferred nodes to serve SST, in order of preference, via the wsrep_sst_donor system variable.
+// Passing no donors clears the preference, allowing any node to be the donor.
+func (c *Client) SetGaleraDonor(ctx context.Context, donors []string, opts ...GaleraDonorOpt) error {
+	query, err := buildSetGaleraDonorQuery(donors, opts...)
+	if err != nil {
+		return fmt.Errorf("error building wsrep_sst_donor query: %v", err)
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildSetGaleraDonorQuery(donors []string, opts ...GaleraDonorOpt) (string, error) {
+	var donorOpts galeraDonorOpts
+	for _, setOpt := range opts {
+		setOpt(&donorOpts)
+	}
+	for _, donor := range donors {
+		if strings.TrimSpace(donor) == "" {
+			return "", errors.New("donor name must not be empty")
+		}
+		if strings.Contains(donor, ",") {
+			return "", fmt.Errorf("invalid donor name '%s': it must not contain commas", donor)
+		}
+	}
+
+	value := strings.Join(donors, ",")
+	// A trailing comma allows falling back to any other node when the preferred donors are not available.
+	if donorOpts.fallback && len(donors) > 0 {
+		value += ","
+	}
+	return fmt.Sprintf("SET @@global.wsrep_sst_donor=%s;", StringLiteral(value)), nil
+}
+
+// SetGaleraProviderOptions sets the wsrep_provider_options, merging them with the current ones. Only the options that differ
+// from the current values are written, as the provider preserves the rest of options and some of them cannot be set at runtime.
+func (c *Client) SetGaleraProviderOptions(ctx context.Context, opts map[string]string) error {
+	current, err := c.globalSystemVariable(ctx, "wsrep_provider_options")
+	if err != nil {
+		return fmt.Errorf("error getting wsrep_provider_options: %v", err)
+	}
+	query, err := buildSetGaleraProviderOptionsQuery(current, opts)
+	if err != nil {
+		return fmt.Errorf("error building wsrep_provider_options query: %v", err)
+	}
+	if query == "" {
+		return nil
+	}
+	return c.Exec(ctx, query)
+}
+
+func buildSetGaleraProviderOptionsQuery(current string, opts map[string]string) (string, error) {
+	currentOpts := parseGaleraProviderOptions(current)
+
+	keys := make([]string, 0, len(opts))
+	for key, value := range opts {
+		if key == "" || strings.ContainsAny(key, ";=") {
+			return "", fmt.Errorf("invalid provider option '%s'", key)
+		}
+		if strings.Contains(value, ";") {
+			return "", fmt.Errorf("invalid value for provider option '%s': it must not contain semicolons", key)
+		}
+		if currentValue, ok := currentOpts[key]; ok && currentValue == value {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+	sort.Strings(keys)
+
+	changed := make([]string, len(keys))
+	for i, key := range keys {
+		changed[i] = fmt.Sprintf("%s=%s", key, opts[key])
+	}
+	return fmt.Sprintf("SET @@global.wsrep_provider_options=%s;", StringLiteral(strings.Join(changed, ";"))), nil
+}
+
+// parseGaleraProviderOptions parses the wsrep_provider_options, which are reported as 'key = value; key = value'.
+func parseGaleraProviderOptions(s string) map[string]string {
+	opts := make(map[string]string)
+	for _, opt := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(opt, "=")
+		if !ok {
+			continue
+		}
+		opts[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return opts
+}
+
 func (c *Client) MaxScaleConfigSyncVersion(ctx context.Context) (int, error) {
 	row := c.db.QueryRowContext(ctx, "SELECT version FROM maxscale_config")
 	var version int
@@ -834,6 +2816,99 @@ func (c *Client) DropMaxScaleConfig(ctx context.Context) error {
 	return c.Exec(ctx, "DROP TABLE maxscale_config")
 }
 
+// TableMaintenanceResult represents a row returned by the OPTIMIZE, ANALYZE, CHECK and REPAIR TABLE statements.
+type TableMaintenanceResult struct {
+	Table   string
+	Op      string
+	MsgType string
+	MsgText string
+}
+
+// TableMaintenanceResults represents the rows returned by a table maintenance statement.
+type TableMaintenanceResults []TableMaintenanceResult
+
+// OK determines whether the table maintenance operation succeeded, i.e. no error rows were returned
+// and the status row reports the table as OK.
+func (r TableMaintenanceResults) OK() bool {
+	var statusOK bool
+	for _, result := range r {
+		switch strings.ToLower(result.MsgType) {
+		case "error":
+			return false
+		case "status":
+			text := strings.ToLower(result.MsgText)
+			statusOK = text == "ok" || text == "table is already up to date"
+		}
+	}
+	return statusOK
+}
+
+// Errors returns the messages of the error rows.
+func (r TableMaintenanceResults) Errors() []string {
+	var errs []string
+	for _, result := range r {
+		if strings.ToLower(result.MsgType) == "error" {
+			errs = append(errs, result.MsgText)
+		}
+	}
+	return errs
+}
+
+func (c *Client) OptimizeTable(ctx context.Context, database, table string) (TableMaintenanceResults, error) {
+	return c.tableMaintenance(ctx, "OPTIMIZE", database, table)
+}
+
+func (c *Client) AnalyzeTable(ctx context.Context, database, table string) (TableMaintenanceResults, error) {
+	return c.tableMaintenance(ctx, "ANALYZE", database, table)
+}
+
+func (c *Client) CheckTable(ctx context.Context, database, table string) (TableMaintenanceResults, error) {
+	return c.tableMaintenance(ctx, "CHECK", database, table)
+}
+
+func (c *Client) RepairTable(ctx context.Context, database, table string) (TableMaintenanceResults, error) {
+	return c.tableMaintenance(ctx, "REPAIR", database, table)
+}
+
+func (c *Client) tableMaintenance(ctx context.Context, op, database, table string) (TableMaintenanceResults, error) {
+	rows, err := c.db.QueryContext(ctx, buildTableMaintenanceQuery(op, database, table))
+	if err != nil {
+		return nil, fmt.Errorf("error executing %s TABLE: %v", op, err)
+	}
+	defer rows.Close()
+
+	results, err := scanTableMaintenanceResults(rows)
+	if err != nil {
+		return nil, fmt.Errorf("error scanning %s TABLE results: %v", op, err)
+	}
+	return results, nil
+}
+
+func buildTableMaintenanceQuery(op, database, table string) string {
+	return fmt.Sprintf("%s TABLE %s.%s;", op, Identifier(database), Identifier(table))
+}
+
+type rowScanner interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+}
+
+func scanTableMaintenanceResults(rows rowScanner) (TableMaintenanceResults, error) {
+	var results TableMaintenanceResults
+	for rows.Next() {
+		var result TableMaintenanceResult
+		if err := rows.Scan(&result.Table, &result.Op, &result.MsgType, &result.MsgText); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 func requireQuery(require *mariadbv1alpha1.TLSRequirements) (string, error) {
 	if require == nil {
 		return "", errors.New("TLS requirements must be set")
@@ -850,10 +2925,10 @@ func requireQuery(require *mariadbv1alpha1.TLSRequirements) (string, error) {
 		tlsOptions = append(tlsOptions, "X509")
 	}
 	if require.Issuer != nil && *require.Issuer != "" {
-		tlsOptions = append(tlsOptions, fmt.Sprintf("ISSUER '%s'", *require.Issuer))
+		tlsOptions = append(tlsOptions, fmt.Sprintf("ISSUER %s", StringLiteral(*require.Issuer)))
 	}
 	if require.Subject != nil && *require.Subject != "" {
-		tlsOptions = append(tlsOptions, fmt.Sprintf("SUBJECT '%s'", *require.Subject))
+		tlsOptions = append(tlsOptions, fmt.Sprintf("SUBJECT %s", StringLiteral(*require.Subject)))
 	}
 
 	if len(tlsOptions) == 0 {
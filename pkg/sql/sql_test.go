@@ -1,181 +1,5074 @@
 package sql
 
 import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-multierror"
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/pki"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
+func TestBuildDSNs(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Fatalf("unexpected error loading location: %v", err)
+	}
+	tests := []struct {
+		name     string
+		opts     Opts
+		wantDSNs []string
+		wantErr  bool
+	}{
+		{
+			name:     "missing host",
+			opts:     Opts{Port: 3306},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "missing port",
+			opts:     Opts{Host: "mariadb-0.mariadb-internal"},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "single host",
+			opts: Opts{
+				Username: "root",
+				Password: "MariaDB11!",
+				Host:     "mariadb-0.mariadb-internal",
+				Port:     3306,
+			},
+			wantDSNs: []string{
+				"root:MariaDB11!@tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "multiple hosts",
+			opts: Opts{
+				Username: "root",
+				Password: "MariaDB11!",
+				Hosts:    []string{"mariadb-0.mariadb-internal", "mariadb-1.mariadb-internal:3307", "10.244.0.12"},
+				Port:     3306,
+				Database: "mariadb",
+			},
+			wantDSNs: []string{
+				"root:MariaDB11!@tcp(mariadb-0.mariadb-internal:3306)/mariadb?timeout=5s",
+				"root:MariaDB11!@tcp(mariadb-1.mariadb-internal:3307)/mariadb?timeout=5s",
+				"root:MariaDB11!@tcp(10.244.0.12:3306)/mariadb?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "hosts take precedence over host",
+			opts: Opts{
+				Host:  "mariadb-primary",
+				Hosts: []string{"mariadb-0.mariadb-internal", "mariadb-1.mariadb-internal"},
+				Port:  3306,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+				"tcp(mariadb-1.mariadb-internal:3306)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "host override takes precedence over host",
+			opts: Opts{
+				Host:         "mariadb-primary",
+				HostOverride: "mariadb-2.mariadb-internal",
+				Port:         3306,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-2.mariadb-internal:3306)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "host override takes precedence over hosts",
+			opts: Opts{
+				Hosts:        []string{"mariadb-0.mariadb-internal", "mariadb-1.mariadb-internal"},
+				HostOverride: "mariadb.example.com:3307",
+				Port:         3306,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb.example.com:3307)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "IPv6 hosts",
+			opts: Opts{
+				Hosts: []string{"::1", "[fd00::1]:3307"},
+				Port:  3306,
+			},
+			wantDSNs: []string{
+				"tcp([::1]:3306)/?timeout=5s",
+				"tcp([fd00::1]:3307)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "hosts with ports and no default port",
+			opts: Opts{
+				Hosts: []string{"mariadb-0.mariadb-internal:3306", "mariadb-1.mariadb-internal:3306"},
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+				"tcp(mariadb-1.mariadb-internal:3306)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "host without port and no default port",
+			opts: Opts{
+				Hosts: []string{"mariadb-0.mariadb-internal:3306", "mariadb-1.mariadb-internal"},
+			},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "empty host",
+			opts: Opts{
+				Hosts: []string{"mariadb-0.mariadb-internal", ""},
+				Port:  3306,
+			},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "empty port",
+			opts: Opts{
+				Hosts: []string{"mariadb-0.mariadb-internal:"},
+				Port:  3306,
+			},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "interpolate params",
+			opts: Opts{
+				Host:              "mariadb-0.mariadb-internal",
+				Port:              3306,
+				InterpolateParams: true,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?interpolateParams=true&timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "location",
+			opts: Opts{
+				Host:     "mariadb-0.mariadb-internal",
+				Port:     3306,
+				Location: madrid,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?loc=Europe%2FMadrid&parseTime=true&timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "UTC location",
+			opts: Opts{
+				Host:     "mariadb-0.mariadb-internal",
+				Port:     3306,
+				Location: time.UTC,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?parseTime=true&timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "interpolate params and location",
+			opts: Opts{
+				Host:              "mariadb-0.mariadb-internal",
+				Port:              3306,
+				InterpolateParams: true,
+				Location:          madrid,
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?interpolateParams=true&loc=Europe%2FMadrid&parseTime=true&timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "max allowed packet",
+			opts: Opts{
+				Host:             "mariadb-0.mariadb-internal",
+				Port:             3306,
+				MaxAllowedPacket: ptr.To(16 << 20),
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&maxAllowedPacket=16777216",
+			},
+			wantErr: false,
+		},
+		{
+			name: "max allowed packet from server",
+			opts: Opts{
+				Host:             "mariadb-0.mariadb-internal",
+				Port:             3306,
+				MaxAllowedPacket: ptr.To(0),
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&maxAllowedPacket=0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "default max allowed packet",
+			opts: Opts{
+				Host:             "mariadb-0.mariadb-internal",
+				Port:             3306,
+				MaxAllowedPacket: ptr.To(64 << 20),
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative max allowed packet",
+			opts: Opts{
+				Host:             "mariadb-0.mariadb-internal",
+				Port:             3306,
+				MaxAllowedPacket: ptr.To(-1),
+			},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "session wait timeout",
+			opts: Opts{
+				Host:               "mariadb-0.mariadb-internal",
+				Port:               3306,
+				SessionWaitTimeout: ptr.To(600),
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&wait_timeout=600",
+			},
+			wantErr: false,
+		},
+		{
+			name: "session wait timeout with params",
+			opts: Opts{
+				Host: "mariadb-0.mariadb-internal",
+				Port: 3306,
+				Params: map[string]string{
+					"autocommit": "1",
+				},
+				SessionWaitTimeout: ptr.To(600),
+			},
+			wantDSNs: []string{
+				"tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&autocommit=1&wait_timeout=600",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid session wait timeout",
+			opts: Opts{
+				Host:               "mariadb-0.mariadb-internal",
+				Port:               3306,
+				SessionWaitTimeout: ptr.To(0),
+			},
+			wantDSNs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsns, err := BuildDSNs(tt.opts)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantDSNs, dsns); diff != "" {
+				t.Errorf("unexpected DSNs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMaxScaleOpts(t *testing.T) {
+	objMeta := metav1.ObjectMeta{
+		Name:      "maxscale",
+		Namespace: "default",
+	}
+	services := []mariadbv1alpha1.MaxScaleService{
+		{
+			Name: "rw-router",
+			Listener: mariadbv1alpha1.MaxScaleListener{
+				Name: "rw-listener",
+				Port: 3306,
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		maxscale       *mariadbv1alpha1.MaxScale
+		clientOpts     []Opt
+		wantHost       string
+		wantPort       int32
+		wantConfigName string
+		wantErr        bool
+	}{
+		{
+			name: "no services",
+			maxscale: &mariadbv1alpha1.MaxScale{
+				ObjectMeta: objMeta,
+			},
+			wantErr: true,
+		},
+		{
+			name: "TLS disabled",
+			maxscale: &mariadbv1alpha1.MaxScale{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MaxScaleSpec{
+					Services: services,
+				},
+			},
+			wantHost:       "maxscale.default.svc.cluster.local",
+			wantPort:       3306,
+			wantConfigName: "",
+			wantErr:        false,
+		},
+		{
+			name: "TLS enabled",
+			maxscale: &mariadbv1alpha1.MaxScale{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MaxScaleSpec{
+					Services: services,
+					TLS: &mariadbv1alpha1.MaxScaleTLS{
+						Enabled: true,
+					},
+				},
+			},
+			wantHost:       "maxscale.default.svc.cluster.local",
+			wantPort:       3306,
+			wantConfigName: "maxscale-maxscale-default",
+			wantErr:        false,
+		},
+		{
+			name: "TLS enabled with client certificate",
+			maxscale: &mariadbv1alpha1.MaxScale{
+				ObjectMeta: objMeta,
+				Spec: mariadbv1alpha1.MaxScaleSpec{
+					Services: services,
+					TLS: &mariadbv1alpha1.MaxScaleTLS{
+						Enabled: true,
+					},
+				},
+			},
+			clientOpts: []Opt{
+				WithTLSClientCert("maxscale-client", []byte("cert"), []byte("key")),
+			},
+			wantHost:       "maxscale.default.svc.cluster.local",
+			wantPort:       3306,
+			wantConfigName: "maxscale-maxscale-default-client-maxscale-client",
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mxsOpts, err := maxscaleOpts(tt.maxscale, []byte("ca"))
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expect error to have occurred, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+
+			var opts Opts
+			for _, setOpt := range append(mxsOpts, tt.clientOpts...) {
+				setOpt(&opts)
+			}
+			if opts.Host != tt.wantHost {
+				t.Errorf("unexpected host, want: %s got: %s", tt.wantHost, opts.Host)
+			}
+			if opts.Port != tt.wantPort {
+				t.Errorf("unexpected port, want: %d got: %d", tt.wantPort, opts.Port)
+			}
+			if opts.MariadbName != "" {
+				t.Errorf("expected MariaDB name to be empty, got: %s", opts.MariadbName)
+			}
+
+			configName, err := configTLSName(opts)
+			if tt.wantConfigName == "" {
+				if err == nil {
+					t.Errorf("expect TLS config name error to have occurred, got: %s", configName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error getting TLS config name: %v", err)
+			}
+			if configName != tt.wantConfigName {
+				t.Errorf("unexpected TLS config name, want: %s got: %s", tt.wantConfigName, configName)
+			}
+		})
+	}
+}
+
+func TestCACertPool(t *testing.T) {
+	caPEM := func(name string) []byte {
+		keyPair, err := pki.CreateCA(pki.WithCommonName(name))
+		if err != nil {
+			t.Fatalf("unexpected error creating CA: %v", err)
+		}
+		return keyPair.CertPEM
+	}
+	invalidCertPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: []byte("invalid"),
+	})
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: []byte("private-key"),
+	})
+
+	tests := []struct {
+		name        string
+		pemBytes    []byte
+		wantSkipped int
+		wantErr     bool
+	}{
+		{
+			name:        "empty",
+			pemBytes:    nil,
+			wantSkipped: 0,
+			wantErr:     true,
+		},
+		{
+			name:        "not PEM",
+			pemBytes:    []byte("foo"),
+			wantSkipped: 0,
+			wantErr:     true,
+		},
+		{
+			name:        "single certificate",
+			pemBytes:    caPEM("ca"),
+			wantSkipped: 0,
+			wantErr:     false,
+		},
+		{
+			name:        "multiple certificates",
+			pemBytes:    bytes.Join([][]byte{caPEM("ca-1"), caPEM("ca-2")}, nil),
+			wantSkipped: 0,
+			wantErr:     false,
+		},
+		{
+			name:        "mixed validity",
+			pemBytes:    bytes.Join([][]byte{caPEM("ca-1"), invalidCertPEM, caPEM("ca-2"), privateKeyPEM}, nil),
+			wantSkipped: 2,
+			wantErr:     false,
+		},
+		{
+			name:        "only invalid",
+			pemBytes:    bytes.Join([][]byte{invalidCertPEM, privateKeyPEM}, nil),
+			wantSkipped: 2,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, skipped, err := caCertPool(tt.pemBytes)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if !tt.wantErr && pool == nil {
+				t.Error("expected CA pool not to be nil")
+			}
+			if skipped != tt.wantSkipped {
+				t.Errorf("unexpected skipped blocks, want: %d got: %d", tt.wantSkipped, skipped)
+			}
+		})
+	}
+}
+
+func TestRedactDSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		wantDSN string
+	}{
+		{
+			name:    "password",
+			dsn:     "root:MariaDB11!@tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+			wantDSN: "root:xxxxx@tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+		},
+		{
+			name:    "password and database",
+			dsn:     "root:MariaDB11!@tcp(mariadb-0.mariadb-internal:3306)/mariadb?timeout=5s",
+			wantDSN: "root:xxxxx@tcp(mariadb-0.mariadb-internal:3306)/mariadb?timeout=5s",
+		},
+		{
+			name:    "password with special characters",
+			dsn:     "root:p@ss:w/rd@tcp(mariadb-0.mariadb-internal:3306)/",
+			wantDSN: "root:xxxxx@tcp(mariadb-0.mariadb-internal:3306)/",
+		},
+		{
+			name:    "empty password",
+			dsn:     "root:@tcp(mariadb-0.mariadb-internal:3306)/",
+			wantDSN: "root:@tcp(mariadb-0.mariadb-internal:3306)/",
+		},
+		{
+			name:    "no password",
+			dsn:     "root@tcp(mariadb-0.mariadb-internal:3306)/",
+			wantDSN: "root@tcp(mariadb-0.mariadb-internal:3306)/",
+		},
+		{
+			name:    "no credentials",
+			dsn:     "tcp(mariadb-0.mariadb-internal:3306)/mariadb",
+			wantDSN: "tcp(mariadb-0.mariadb-internal:3306)/mariadb",
+		},
+		{
+			name:    "invalid",
+			dsn:     "invalid-dsn",
+			wantDSN: "invalid-dsn",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if dsn := RedactDSN(tt.dsn); dsn != tt.wantDSN {
+				t.Errorf("unexpected DSN, want: %s got: %s", tt.wantDSN, dsn)
+			}
+		})
+	}
+}
+
+func TestConnectWithFailover(t *testing.T) {
+	tests := []struct {
+		name         string
+		dsns         []string
+		available    map[string]bool
+		wantDSN      string
+		wantAttempts []string
+		wantErr      bool
+	}{
+		{
+			name:         "no DSNs",
+			dsns:         nil,
+			available:    nil,
+			wantDSN:      "",
+			wantAttempts: nil,
+			wantErr:      true,
+		},
+		{
+			name:         "first available",
+			dsns:         []string{"mariadb-0", "mariadb-1", "mariadb-2"},
+			available:    map[string]bool{"mariadb-0": true, "mariadb-1": true, "mariadb-2": true},
+			wantDSN:      "mariadb-0",
+			wantAttempts: []string{"mariadb-0"},
+			wantErr:      false,
+		},
+		{
+			name:         "failover",
+			dsns:         []string{"mariadb-0", "mariadb-1", "mariadb-2"},
+			available:    map[string]bool{"mariadb-2": true},
+			wantDSN:      "mariadb-2",
+			wantAttempts: []string{"mariadb-0", "mariadb-1", "mariadb-2"},
+			wantErr:      false,
+		},
+		{
+			name:         "none available",
+			dsns:         []string{"mariadb-0", "mariadb-1"},
+			available:    nil,
+			wantDSN:      "",
+			wantAttempts: []string{"mariadb-0", "mariadb-1"},
+			wantErr:      true,
+		},
+		{
+			name:         "single unavailable",
+			dsns:         []string{"mariadb-0"},
+			available:    nil,
+			wantDSN:      "",
+			wantAttempts: []string{"mariadb-0"},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts []string
+			dbs := make(map[*sql.DB]string)
+			connect := func(dsn string) (*sql.DB, error) {
+				attempts = append(attempts, dsn)
+				if !tt.available[dsn] {
+					return nil, fmt.Errorf("dial tcp %s: connection refused", dsn)
+				}
+				db := &sql.DB{}
+				dbs[db] = dsn
+				return db, nil
+			}
+
+			db, err := connectWithFailover(tt.dsns, connect)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if dsn := dbs[db]; dsn != tt.wantDSN {
+				t.Errorf("unexpected DSN, want: %s got: %s", tt.wantDSN, dsn)
+			}
+			if diff := cmp.Diff(tt.wantAttempts, attempts); diff != "" {
+				t.Errorf("unexpected attempts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTestAuth(t *testing.T) {
+	opts := Opts{
+		Username: "root",
+		Password: "MariaDB11!",
+		Hosts:    []string{"mariadb-0", "mariadb-1"},
+		Port:     3306,
+		Database: "mariadb",
+	}
+	tests := []struct {
+		name         string
+		account      string
+		password     string
+		available    map[string]bool
+		wantAttempts []string
+		wantErr      error
+		wantAnyErr   bool
+	}{
+		{
+			name:         "valid credentials",
+			account:      "'mariadb'@'%'",
+			password:     "MariaDB11!",
+			available:    map[string]bool{"mariadb-0:3306": true, "mariadb-1:3306": true},
+			wantAttempts: []string{"mariadb:MariaDB11!@mariadb-0:3306"},
+		},
+		{
+			name:         "valid credentials with plain user",
+			account:      "mariadb",
+			password:     "MariaDB11!",
+			available:    map[string]bool{"mariadb-0:3306": true, "mariadb-1:3306": true},
+			wantAttempts: []string{"mariadb:MariaDB11!@mariadb-0:3306"},
+		},
+		{
+			name:         "invalid password",
+			account:      "'mariadb'@'%'",
+			password:     "foo",
+			available:    map[string]bool{"mariadb-0:3306": true, "mariadb-1:3306": true},
+			wantAttempts: []string{"mariadb:foo@mariadb-0:3306"},
+			wantErr:      ErrAccessDenied,
+		},
+		{
+			name:         "unknown user",
+			account:      "'foo'@'%'",
+			password:     "MariaDB11!",
+			available:    map[string]bool{"mariadb-0:3306": true, "mariadb-1:3306": true},
+			wantAttempts: []string{"foo:MariaDB11!@mariadb-0:3306"},
+			wantErr:      ErrAccessDenied,
+		},
+		{
+			name:         "failover",
+			account:      "'mariadb'@'%'",
+			password:     "MariaDB11!",
+			available:    map[string]bool{"mariadb-1:3306": true},
+			wantAttempts: []string{"mariadb:MariaDB11!@mariadb-0:3306", "mariadb:MariaDB11!@mariadb-1:3306"},
+		},
+		{
+			name:         "none available",
+			account:      "'mariadb'@'%'",
+			password:     "MariaDB11!",
+			available:    nil,
+			wantAttempts: []string{"mariadb:MariaDB11!@mariadb-0:3306", "mariadb:MariaDB11!@mariadb-1:3306"},
+			wantAnyErr:   true,
+		},
+		{
+			name:         "invalid account",
+			account:      "@'%'",
+			password:     "MariaDB11!",
+			available:    map[string]bool{"mariadb-0:3306": true, "mariadb-1:3306": true},
+			wantAttempts: nil,
+			wantAnyErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts []string
+			ping := func(ctx context.Context, dsn string) error {
+				config, err := mysql.ParseDSN(dsn)
+				if err != nil {
+					t.Fatalf("unexpected error parsing DSN: %v", err)
+				}
+				if config.DBName != "" {
+					t.Errorf("unexpected database in DSN: %s", config.DBName)
+				}
+				attempts = append(attempts, fmt.Sprintf("%s:%s@%s", config.User, config.Passwd, config.Addr))
+				if !tt.available[config.Addr] {
+					return fmt.Errorf("dial tcp %s: connection refused", config.Addr)
+				}
+				if config.User != "mariadb" || config.Passwd != "MariaDB11!" {
+					return &mysql.MySQLError{Number: 1045, Message: fmt.Sprintf("Access denied for user '%s'@'10.244.0.1'", config.User)}
+				}
+				return nil
+			}
+
+			err := testAuth(context.Background(), opts, tt.account, tt.password, ping)
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("unexpected error, want: %v got: %v", tt.wantErr, err)
+			}
+			if tt.wantAnyErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if tt.wantErr == nil && !tt.wantAnyErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if tt.wantAnyErr && errors.Is(err, ErrAccessDenied) {
+				t.Errorf("unexpected access denied error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantAttempts, attempts); diff != "" {
+				t.Errorf("unexpected attempts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTestAuthTLS(t *testing.T) {
+	keyPair, err := pki.CreateCA(pki.WithCommonName("mariadb-ca"))
+	if err != nil {
+		t.Fatalf("unexpected error creating CA: %v", err)
+	}
+	opts := Opts{
+		Username:            "root",
+		Password:            "MariaDB11!",
+		Host:                "mariadb-0",
+		Port:                3306,
+		MariadbName:         "mariadb",
+		Namespace:           "default",
+		TLSCACert:           keyPair.CertPEM,
+		ClientName:          "root",
+		TLSClientCert:       keyPair.CertPEM,
+		TLSClientPrivateKey: keyPair.KeyPEM,
+	}
+
+	var attempts int
+	ping := func(ctx context.Context, dsn string) error {
+		attempts++
+		config, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			t.Fatalf("unexpected error parsing DSN: %v", err)
+		}
+		if config.TLSConfig != "mariadb-mariadb-default" {
+			t.Errorf("unexpected TLS config name: %s", config.TLSConfig)
+		}
+		if config.TLS == nil || config.TLS.RootCAs == nil {
+			t.Error("expected TLS config to trust the CA")
+		}
+		if config.TLS != nil && len(config.TLS.Certificates) > 0 {
+			t.Error("expected TLS config to not present the client certificate")
+		}
+		return nil
+	}
+
+	if err := testAuth(context.Background(), opts, "'mariadb'@'%'", "MariaDB11!", ping); err != nil {
+		t.Errorf("unexpected error testing auth: %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("unexpected number of attempts: %d", attempts)
+	}
+}
+
 func TestBuildChangeMasterQuery(t *testing.T) {
 	tests := []struct {
 		name      string
-		options   []ChangeMasterOpt
+		options   []ChangeMasterOpt
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name: "missing host",
+			options: []ChangeMasterOpt{
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name: "missing credentials",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name: "valid without SSL",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterGtid("CurrentPos"),
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='127.0.0.1',
+MASTER_PORT=3306,
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_CONNECT_RETRY=10;
+`,
+			wantErr: false,
+		},
+		{
+			name: "missing SSL paths",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterSSL("", "", ""),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name: "valid with SSL",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterGtid("CurrentPos"),
+				WithChangeMasterSSL("/etc/pki/client.crt", "/etc/pki/client.key", "/etc/pki/ca.crt"),
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='127.0.0.1',
+MASTER_PORT=3306,
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_CONNECT_RETRY=10,
+MASTER_SSL=1,
+MASTER_SSL_CERT='/etc/pki/client.crt',
+MASTER_SSL_KEY='/etc/pki/client.key',
+MASTER_SSL_CA='/etc/pki/ca.crt',
+MASTER_SSL_VERIFY_SERVER_CERT=1;
+`,
+			wantErr: false,
+		},
+		{
+			name: "valid with SSL cipher",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterGtid("CurrentPos"),
+				WithChangeMasterSSL("/etc/pki/client.crt", "/etc/pki/client.key", "/etc/pki/ca.crt"),
+				WithChangeMasterSSLCipher("TLS_AES_256_GCM_SHA384"),
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='127.0.0.1',
+MASTER_PORT=3306,
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_CONNECT_RETRY=10,
+MASTER_SSL=1,
+MASTER_SSL_CERT='/etc/pki/client.crt',
+MASTER_SSL_KEY='/etc/pki/client.key',
+MASTER_SSL_CA='/etc/pki/ca.crt',
+MASTER_SSL_CIPHER='TLS_AES_256_GCM_SHA384',
+MASTER_SSL_VERIFY_SERVER_CERT=1;
+`,
+			wantErr: false,
+		},
+		{
+			name: "SSL cipher with quotes",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterGtid("CurrentPos"),
+				WithChangeMasterSSL("/etc/pki/client.crt", "/etc/pki/client.key", "/etc/pki/ca.crt"),
+				WithChangeMasterSSLCipher("AES256', MASTER_HOST='attacker"),
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='127.0.0.1',
+MASTER_PORT=3306,
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_CONNECT_RETRY=10,
+MASTER_SSL=1,
+MASTER_SSL_CERT='/etc/pki/client.crt',
+MASTER_SSL_KEY='/etc/pki/client.key',
+MASTER_SSL_CA='/etc/pki/ca.crt',
+MASTER_SSL_CIPHER='AES256'', MASTER_HOST=''attacker',
+MASTER_SSL_VERIFY_SERVER_CERT=1;
+`,
+			wantErr: false,
+		},
+		{
+			name: "SSL cipher without SSL",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterSSLCipher("TLS_AES_256_GCM_SHA384"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name: "valid with ignore server IDs",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterGtid("CurrentPos"),
+				WithChangeMasterIgnoreServerIds([]int{10, 11}),
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='127.0.0.1',
+MASTER_PORT=3306,
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_CONNECT_RETRY=10,
+IGNORE_SERVER_IDS=(10,11);
+`,
+			wantErr: false,
+		},
+		{
+			name: "invalid ignore server IDs",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterIgnoreServerIds([]int{10, -1}),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name: "valid with ignore server IDs and SSL",
+			options: []ChangeMasterOpt{
+				WithChangeMasterHost("127.0.0.1"),
+				WithChangeMasterPort(3306),
+				WithChangeMasterCredentials("repl", "password"),
+				WithChangeMasterGtid("SlavePos"),
+				WithChangeMasterIgnoreServerIds([]int{12}),
+				WithChangeMasterSSL("/etc/pki/client.crt", "/etc/pki/client.key", "/etc/pki/ca.crt"),
+				WithChangeMasterSSLCipher("ECDHE-RSA-AES256-GCM-SHA384"),
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='127.0.0.1',
+MASTER_PORT=3306,
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=SlavePos,
+MASTER_CONNECT_RETRY=10,
+IGNORE_SERVER_IDS=(12),
+MASTER_SSL=1,
+MASTER_SSL_CERT='/etc/pki/client.crt',
+MASTER_SSL_KEY='/etc/pki/client.key',
+MASTER_SSL_CA='/etc/pki/ca.crt',
+MASTER_SSL_CIPHER='ECDHE-RSA-AES256-GCM-SHA384',
+MASTER_SSL_VERIFY_SERVER_CERT=1;
+`,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := buildChangeMasterQuery(tt.options...)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(query, tt.wantQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildResetMasterKeepGtidQueries(t *testing.T) {
+	tests := []struct {
+		name        string
+		gtidState   string
+		wantQueries []string
+	}{
+		{
+			name:      "empty state",
+			gtidState: "",
+			wantQueries: []string{
+				"RESET MASTER;",
+			},
+		},
+		{
+			name:      "single domain",
+			gtidState: "0-10-42",
+			wantQueries: []string{
+				"RESET MASTER;",
+				"SET @@global.gtid_binlog_state='0-10-42';",
+			},
+		},
+		{
+			name:      "multiple servers and domains",
+			gtidState: "0-10-42,0-11-40,1-20-7",
+			wantQueries: []string{
+				"RESET MASTER;",
+				"SET @@global.gtid_binlog_state='0-10-42,0-11-40,1-20-7';",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := buildResetMasterKeepGtidQueries(tt.gtidState)
+			if diff := cmp.Diff(tt.wantQueries, queries); diff != "" {
+				t.Errorf("unexpected queries (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResetMasterKeepGtid(t *testing.T) {
+	tests := []struct {
+		name        string
+		gtidState   string
+		gtidErr     error
+		binlogs     int
+		binlogsErr  error
+		execErr     error
+		wantQueries []string
+		wantErr     bool
+	}{
+		{
+			name:      "GTID state",
+			gtidState: "0-10-42",
+			binlogs:   3,
+			wantQueries: []string{
+				"RESET MASTER;",
+				"SET @@global.gtid_binlog_state='0-10-42';",
+			},
+			wantErr: false,
+		},
+		{
+			name:        "error reading GTID state",
+			gtidErr:     errors.New("invalid connection"),
+			binlogs:     3,
+			wantQueries: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "empty GTID state with binary logs",
+			gtidState:   "",
+			binlogs:     1,
+			wantQueries: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "empty GTID state with error counting binary logs",
+			gtidState:   "",
+			binlogsErr:  errors.New("invalid connection"),
+			wantQueries: nil,
+			wantErr:     true,
+		},
+		{
+			name:      "empty GTID state without binary logs",
+			gtidState: "",
+			binlogs:   0,
+			wantQueries: []string{
+				"RESET MASTER;",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "error executing",
+			gtidState: "0-10-42",
+			execErr:   errors.New("access denied"),
+			wantQueries: []string{
+				"RESET MASTER;",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries []string
+			err := resetMasterKeepGtid(
+				context.Background(),
+				func(ctx context.Context, variable string) (string, error) {
+					if variable != "gtid_binlog_state" {
+						t.Errorf("unexpected variable: %s", variable)
+					}
+					return tt.gtidState, tt.gtidErr
+				},
+				func(ctx context.Context) (int, error) {
+					return tt.binlogs, tt.binlogsErr
+				},
+				func(ctx context.Context, sql string, args ...any) error {
+					queries = append(queries, sql)
+					return tt.execErr
+				},
+			)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQueries, queries); diff != "" {
+				t.Errorf("unexpected queries (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildPurgeBinaryLogsBeforeQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		time      time.Time
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "zero",
+			time:      time.Time{},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "UTC",
+			time:      time.Date(2026, 10, 16, 10, 30, 15, 0, time.UTC),
+			wantQuery: "PURGE BINARY LOGS BEFORE '2026-10-16 10:30:15';",
+			wantErr:   false,
+		},
+		{
+			name:      "non UTC",
+			time:      time.Date(2026, 10, 16, 12, 30, 15, 0, time.FixedZone("CEST", 2*60*60)),
+			wantQuery: "PURGE BINARY LOGS BEFORE '2026-10-16 10:30:15';",
+			wantErr:   false,
+		},
+		{
+			name:      "sub-second precision",
+			time:      time.Date(2026, 10, 16, 10, 30, 15, 999999999, time.UTC),
+			wantQuery: "PURGE BINARY LOGS BEFORE '2026-10-16 10:30:15';",
+			wantErr:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildPurgeBinaryLogsBeforeQuery(tt.time)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildStartSlaveUntilQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		connName  string
+		gtid      string
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "missing GTID",
+			connName:  "mariadb-operator",
+			gtid:      "",
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "single domain",
+			connName:  "mariadb-operator",
+			gtid:      "0-10-42",
+			wantQuery: "START SLAVE 'mariadb-operator' UNTIL master_gtid_pos = '0-10-42';",
+			wantErr:   false,
+		},
+		{
+			name:      "multiple domains",
+			connName:  "mariadb-operator",
+			gtid:      "0-10-42,1-20-7",
+			wantQuery: "START SLAVE 'mariadb-operator' UNTIL master_gtid_pos = '0-10-42,1-20-7';",
+			wantErr:   false,
+		},
+		{
+			name:      "quotes",
+			connName:  "mariadb'operator",
+			gtid:      "0-10-42'; STOP ALL SLAVES; --",
+			wantQuery: "START SLAVE 'mariadb''operator' UNTIL master_gtid_pos = '0-10-42''; STOP ALL SLAVES; --';",
+			wantErr:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildStartSlaveUntilQuery(tt.connName, tt.gtid)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSetGtidSlavePosQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		gtid      string
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "empty",
+			gtid:      "",
+			wantQuery: "SET @@global.gtid_slave_pos='';",
+			wantErr:   false,
+		},
+		{
+			name:      "single domain",
+			gtid:      "0-10-42",
+			wantQuery: "SET @@global.gtid_slave_pos='0-10-42';",
+			wantErr:   false,
+		},
+		{
+			name:      "multiple domains",
+			gtid:      "0-10-42,1-20-7",
+			wantQuery: "SET @@global.gtid_slave_pos='0-10-42,1-20-7';",
+			wantErr:   false,
+		},
+		{
+			name:      "duplicated domain",
+			gtid:      "0-10-42,0-11-40",
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "missing sequence",
+			gtid:      "0-10",
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "negative sequence",
+			gtid:      "0-10--42",
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "injection",
+			gtid:      "0-10-42'; STOP ALL SLAVES; --",
+			wantQuery: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildSetGtidSlavePosQuery(tt.gtid)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRequireQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		require   *mariadbv1alpha1.TLSRequirements
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "nil",
+			require:   nil,
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "empty",
+			require:   &mariadbv1alpha1.TLSRequirements{},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name: "SSL",
+			require: &mariadbv1alpha1.TLSRequirements{
+				SSL: ptr.To(true),
+			},
+			wantQuery: "REQUIRE SSL",
+			wantErr:   false,
+		},
+		{
+			name: "X509",
+			require: &mariadbv1alpha1.TLSRequirements{
+				X509: ptr.To(true),
+			},
+			wantQuery: "REQUIRE X509",
+			wantErr:   false,
+		},
+		{
+			name: "Issuer",
+			require: &mariadbv1alpha1.TLSRequirements{
+				Issuer: ptr.To("/CN=mariadb-galera-ca"),
+			},
+			wantQuery: "REQUIRE ISSUER '/CN=mariadb-galera-ca'",
+			wantErr:   false,
+		},
+		{
+			name: "Subject",
+			require: &mariadbv1alpha1.TLSRequirements{
+				Subject: ptr.To("/CN=mariadb-galera-client"),
+			},
+			wantQuery: "REQUIRE SUBJECT '/CN=mariadb-galera-client'",
+			wantErr:   false,
+		},
+		{
+			name: "Issuer and Subject",
+			require: &mariadbv1alpha1.TLSRequirements{
+				Issuer:  ptr.To("/CN=mariadb-galera-ca"),
+				Subject: ptr.To("/CN=mariadb-galera-client"),
+			},
+			wantQuery: "REQUIRE ISSUER '/CN=mariadb-galera-ca' AND SUBJECT '/CN=mariadb-galera-client'",
+			wantErr:   false,
+		},
+		{
+			name: "Subject with quote",
+			require: &mariadbv1alpha1.TLSRequirements{
+				Subject: ptr.To("/O=O'Brien/CN=mariadb-galera-client"),
+			},
+			wantQuery: "REQUIRE SUBJECT '/O=O''Brien/CN=mariadb-galera-client'",
+			wantErr:   false,
+		},
+		{
+			name: "Multiple",
+			require: &mariadbv1alpha1.TLSRequirements{
+				SSL:     ptr.To(true),
+				X509:    ptr.To(true),
+				Issuer:  ptr.To("/CN=mariadb-galera-ca"),
+				Subject: ptr.To("/CN=mariadb-galera-client"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := requireQuery(tt.require)
+
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected bundle content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSetRoleQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      string
+		wantQuery string
+	}{
+		{
+			name:      "set",
+			role:      "mariadb-operator",
+			wantQuery: "SET ROLE `mariadb-operator`;",
+		},
+		{
+			name:      "clear",
+			role:      "",
+			wantQuery: "SET ROLE NONE;",
+		},
+		{
+			name:      "injection attempt",
+			role:      "admin`; DROP DATABASE mysql; --",
+			wantQuery: "SET ROLE `admin``; DROP DATABASE mysql; --`;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.wantQuery, buildSetRoleQuery(tt.role)); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildCreateUserQuery(t *testing.T) {
+	account := NewAccount("bob", "%")
+	tests := []struct {
+		name      string
+		account   Account
+		options   []CreateUserOpt
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "no credentials",
+			account:   account,
+			options:   nil,
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' WITH MAX_USER_CONNECTIONS 0 ACCOUNT LOCK PASSWORD EXPIRE ;",
+			wantErr:   false,
+		},
+		{
+			name:    "no credentials no lock",
+			account: account,
+			options: []CreateUserOpt{
+				WithNoLock(),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "require none locked",
+			account: account,
+			options: []CreateUserOpt{
+				WithRequireNone(),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' REQUIRE NONE WITH MAX_USER_CONNECTIONS 0 ACCOUNT LOCK PASSWORD EXPIRE ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified by",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedBy("MariaDB11!"),
+				WithMaxUserConnections(10),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED BY 'MariaDB11!' WITH MAX_USER_CONNECTIONS 10 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified by with quote",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedBy("Maria'DB11!"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED BY 'Maria''DB11!' WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified by password",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword("*57685B4F0FF9D049082E296E2C39354B7A98774E"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED BY PASSWORD '*57685B4F0FF9D049082E296E2C39354B7A98774E' " +
+				"WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr: false,
+		},
+		{
+			name:    "identified via",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedVia("pam"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA pam WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified via using",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedVia("ed25519"),
+				WithIdentifiedViaUsing("ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA ed25519 USING 'ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY' " +
+				"WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr: false,
+		},
+		{
+			name:    "identified via using with quote",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedVia("pam"),
+				WithIdentifiedViaUsing("mariadb'; DROP USER 'root"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA pam USING 'mariadb''; DROP USER ''root' " +
+				"WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr: false,
+		},
+		{
+			name:    "identified via as",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519", "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA ed25519 AS 'ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY' " +
+				"WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr: false,
+		},
+		{
+			name:    "identified via as pam service",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("pam", "mariadb"),
+				WithMaxUserConnections(10),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA pam AS 'mariadb' WITH MAX_USER_CONNECTIONS 10 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified via as with quote",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("pam", "mariadb'; DROP USER 'root"),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA pam AS 'mariadb''; DROP USER ''root' " +
+				"WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr: false,
+		},
+		{
+			name:    "identified via as with backslash",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519", `hash\`),
+			},
+			wantQuery: `CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED VIA ed25519 AS 'hash\\' WITH MAX_USER_CONNECTIONS 0 ;`,
+			wantErr:   false,
+		},
+		{
+			name:    "identified via using and as",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519", "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+				WithIdentifiedViaUsing("ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:    "identified via invalid plugin",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedVia("pam; DROP USER root"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:    "require none",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedBy("MariaDB11!"),
+				WithRequireNone(),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' IDENTIFIED BY 'MariaDB11!' REQUIRE NONE WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "require",
+			account: account,
+			options: []CreateUserOpt{
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{
+					X509: ptr.To(true),
+				}),
+			},
+			wantQuery: "CREATE USER IF NOT EXISTS 'bob'@'%' REQUIRE X509 WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "invalid require",
+			account: account,
+			options: []CreateUserOpt{
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{}),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildCreateUserQuery(tt.account, tt.options...)
+
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildAlterUserQuery(t *testing.T) {
+	account := NewAccount("bob", "%")
+	tests := []struct {
+		name      string
+		account   Account
+		options   []CreateUserOpt
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "no credentials",
+			account:   account,
+			options:   nil,
+			wantQuery: "ALTER USER 'bob'@'%' WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified by with quote",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedBy("Maria'DB11!"),
+				WithMaxUserConnections(10),
+			},
+			wantQuery: "ALTER USER 'bob'@'%' IDENTIFIED BY 'Maria''DB11!' WITH MAX_USER_CONNECTIONS 10 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified via using with quote",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedVia("pam"),
+				WithIdentifiedViaUsing("mariadb'; DROP USER 'root"),
+			},
+			wantQuery: "ALTER USER 'bob'@'%' IDENTIFIED VIA pam USING 'mariadb''; DROP USER ''root' WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "identified via as",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519", "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantQuery: "ALTER USER 'bob'@'%' IDENTIFIED VIA ed25519 AS 'ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY' " +
+				"WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr: false,
+		},
+		{
+			name:    "identified via using and as",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("pam", "mariadb"),
+				WithIdentifiedViaUsing("mariadb"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:    "identified via invalid plugin",
+			account: account,
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519 OR unix_socket", "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:    "require none",
+			account: account,
+			options: []CreateUserOpt{
+				WithRequireNone(),
+			},
+			wantQuery: "ALTER USER 'bob'@'%' REQUIRE NONE WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "require none overrides requirements",
+			account: account,
+			options: []CreateUserOpt{
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{
+					X509: ptr.To(true),
+				}),
+				WithRequireNone(),
+			},
+			wantQuery: "ALTER USER 'bob'@'%' REQUIRE NONE WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "require none overrides invalid requirements",
+			account: account,
+			options: []CreateUserOpt{
+				WithRequireNone(),
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{}),
+			},
+			wantQuery: "ALTER USER 'bob'@'%' REQUIRE NONE WITH MAX_USER_CONNECTIONS 0 ;",
+			wantErr:   false,
+		},
+		{
+			name:    "invalid require",
+			account: account,
+			options: []CreateUserOpt{
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{}),
+			},
+			wantQuery: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildAlterUserQuery(tt.account, tt.options...)
+
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildCreateDatabaseQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		database  string
+		opts      DatabaseOpts
+		wantQuery string
+	}{
+		{
+			name:      "database",
+			database:  "mariadb",
+			opts:      DatabaseOpts{},
+			wantQuery: "CREATE DATABASE `mariadb` ;",
+		},
+		{
+			name:     "character set and collate",
+			database: "mariadb",
+			opts: DatabaseOpts{
+				CharacterSet: "utf8mb4",
+				Collate:      "utf8mb4_general_ci",
+			},
+			wantQuery: "CREATE DATABASE `mariadb` CHARACTER SET = 'utf8mb4' COLLATE = 'utf8mb4_general_ci' ;",
+		},
+		{
+			name:      "database with backtick",
+			database:  "foo`; DROP DATABASE `mysql",
+			opts:      DatabaseOpts{},
+			wantQuery: "CREATE DATABASE `foo``; DROP DATABASE ``mysql` ;",
+		},
+		{
+			name:     "character set with quote",
+			database: "mariadb",
+			opts: DatabaseOpts{
+				CharacterSet: "utf8'; DROP DATABASE mysql; --",
+			},
+			wantQuery: "CREATE DATABASE `mariadb` CHARACTER SET = 'utf8''; DROP DATABASE mysql; --' ;",
+		},
+		{
+			name:     "comment",
+			database: "mariadb",
+			opts: DatabaseOpts{
+				Comment: "application data",
+			},
+			wantQuery: "CREATE DATABASE `mariadb` COMMENT = 'application data' ;",
+		},
+		{
+			name:     "character set, collate and comment with quote",
+			database: "mariadb",
+			opts: DatabaseOpts{
+				CharacterSet: "utf8mb4",
+				Collate:      "utf8mb4_general_ci",
+				Comment:      "mariadb's data",
+			},
+			wantQuery: "CREATE DATABASE `mariadb` CHARACTER SET = 'utf8mb4' COLLATE = 'utf8mb4_general_ci' COMMENT = 'mariadb''s data' ;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery := buildCreateDatabaseQuery(tt.database, tt.opts)
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildTableMaintenanceQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		op        string
+		database  string
+		table     string
+		wantQuery string
+	}{
+		{
+			name:      "optimize",
+			op:        "OPTIMIZE",
+			database:  "mariadb",
+			table:     "users",
+			wantQuery: "OPTIMIZE TABLE `mariadb`.`users`;",
+		},
+		{
+			name:      "analyze",
+			op:        "ANALYZE",
+			database:  "mariadb",
+			table:     "users",
+			wantQuery: "ANALYZE TABLE `mariadb`.`users`;",
+		},
+		{
+			name:      "check",
+			op:        "CHECK",
+			database:  "mariadb",
+			table:     "users",
+			wantQuery: "CHECK TABLE `mariadb`.`users`;",
+		},
+		{
+			name:      "repair",
+			op:        "REPAIR",
+			database:  "mariadb",
+			table:     "users",
+			wantQuery: "REPAIR TABLE `mariadb`.`users`;",
+		},
+		{
+			name:      "backticks",
+			op:        "CHECK",
+			database:  "maria`db",
+			table:     "users`; DROP TABLE `users",
+			wantQuery: "CHECK TABLE `maria``db`.`users``; DROP TABLE ``users`;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery := buildTableMaintenanceQuery(tt.op, tt.database, tt.table)
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScanCheckTableResults(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        *fakeRows
+		wantResults TableMaintenanceResults
+		wantOK      bool
+		wantErrors  []string
+		wantErr     bool
+	}{
+		{
+			name:        "no rows",
+			rows:        &fakeRows{},
+			wantResults: nil,
+			wantOK:      false,
+			wantErrors:  nil,
+			wantErr:     false,
+		},
+		{
+			name: "ok",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"mariadb.users", "check", "status", "OK"},
+				},
+			},
+			wantResults: TableMaintenanceResults{
+				{Table: "mariadb.users", Op: "check", MsgType: "status", MsgText: "OK"},
+			},
+			wantOK:     true,
+			wantErrors: nil,
+			wantErr:    false,
+		},
+		{
+			name: "already up to date",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"mariadb.users", "check", "status", "Table is already up to date"},
+				},
+			},
+			wantResults: TableMaintenanceResults{
+				{Table: "mariadb.users", Op: "check", MsgType: "status", MsgText: "Table is already up to date"},
+			},
+			wantOK:     true,
+			wantErrors: nil,
+			wantErr:    false,
+		},
+		{
+			name: "warning",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"mariadb.users", "check", "warning", "1 client is using or hasn't closed the table properly"},
+					{"mariadb.users", "check", "status", "OK"},
+				},
+			},
+			wantResults: TableMaintenanceResults{
+				{Table: "mariadb.users", Op: "check", MsgType: "warning", MsgText: "1 client is using or hasn't closed the table properly"},
+				{Table: "mariadb.users", Op: "check", MsgType: "status", MsgText: "OK"},
+			},
+			wantOK:     true,
+			wantErrors: nil,
+			wantErr:    false,
+		},
+		{
+			name: "corrupted",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"mariadb.users", "check", "error", "Found key at page 4096 that points to record outside datafile"},
+					{"mariadb.users", "check", "error", "Corrupt"},
+				},
+			},
+			wantResults: TableMaintenanceResults{
+				{Table: "mariadb.users", Op: "check", MsgType: "error", MsgText: "Found key at page 4096 that points to record outside datafile"},
+				{Table: "mariadb.users", Op: "check", MsgType: "error", MsgText: "Corrupt"},
+			},
+			wantOK:     false,
+			wantErrors: []string{"Found key at page 4096 that points to record outside datafile", "Corrupt"},
+			wantErr:    false,
+		},
+		{
+			name: "missing table",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"mariadb.foo", "check", "Error", "Table 'mariadb.foo' doesn't exist"},
+					{"mariadb.foo", "check", "status", "Operation failed"},
+				},
+			},
+			wantResults: TableMaintenanceResults{
+				{Table: "mariadb.foo", Op: "check", MsgType: "Error", MsgText: "Table 'mariadb.foo' doesn't exist"},
+				{Table: "mariadb.foo", Op: "check", MsgType: "status", MsgText: "Operation failed"},
+			},
+			wantOK:     false,
+			wantErrors: []string{"Table 'mariadb.foo' doesn't exist"},
+			wantErr:    false,
+		},
+		{
+			name: "rows error",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"mariadb.users", "check", "status", "OK"},
+				},
+				err: errors.New("connection reset"),
+			},
+			wantResults: nil,
+			wantOK:      false,
+			wantErrors:  nil,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := scanTableMaintenanceResults(tt.rows)
+			if tt.wantErr && err == nil {
+				t.Fatal("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantResults, results); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
+			}
+			if ok := results.OK(); ok != tt.wantOK {
+				t.Errorf("unexpected OK, want: %v got: %v", tt.wantOK, ok)
+			}
+			if errs := results.Errors(); !reflect.DeepEqual(tt.wantErrors, errs) {
+				t.Errorf("unexpected errors, want: %v got: %v", tt.wantErrors, errs)
+			}
+		})
+	}
+}
+
+func TestScanSizeBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     *fakeRows
+		wantSize int64
+		wantErr  bool
+	}{
+		{
+			name:     "no tables",
+			rows:     &fakeRows{},
+			wantSize: 0,
+			wantErr:  false,
+		},
+		{
+			name: "single table",
+			rows: &fakeRows{
+				rows: [][]any{
+					{int64(16384), int64(32768)},
+				},
+			},
+			wantSize: 49152,
+			wantErr:  false,
+		},
+		{
+			name: "multiple tables",
+			rows: &fakeRows{
+				rows: [][]any{
+					{int64(16384), int64(0)},
+					{int64(1589248), int64(442368)},
+					{int64(65536), int64(16384)},
+				},
+			},
+			wantSize: 2129920,
+			wantErr:  false,
+		},
+		{
+			name: "view",
+			rows: &fakeRows{
+				rows: [][]any{
+					{int64(16384), int64(16384)},
+					{nil, nil},
+				},
+			},
+			wantSize: 32768,
+			wantErr:  false,
+		},
+		{
+			name: "unsigned bytes",
+			rows: &fakeRows{
+				rows: [][]any{
+					{[]byte("16384"), []byte("16384")},
+				},
+			},
+			wantSize: 32768,
+			wantErr:  false,
+		},
+		{
+			name: "invalid size",
+			rows: &fakeRows{
+				rows: [][]any{
+					{"foo", int64(0)},
+				},
+			},
+			wantSize: 0,
+			wantErr:  true,
+		},
+		{
+			name: "rows error",
+			rows: &fakeRows{
+				rows: [][]any{
+					{int64(16384), int64(16384)},
+				},
+				err: errors.New("connection reset"),
+			},
+			wantSize: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := scanSizeBytes(tt.rows)
+			if tt.wantErr && err == nil {
+				t.Fatal("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if size != tt.wantSize {
+				t.Errorf("unexpected size, want: %d got: %d", tt.wantSize, size)
+			}
+		})
+	}
+}
+
+func TestBuildKillQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		connID    int64
+		queryOnly bool
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "kill connection",
+			connID:    42,
+			queryOnly: false,
+			wantQuery: "KILL CONNECTION 42;",
+			wantErr:   false,
+		},
+		{
+			name:      "kill query",
+			connID:    42,
+			queryOnly: true,
+			wantQuery: "KILL QUERY 42;",
+			wantErr:   false,
+		},
+		{
+			name:      "zero connection ID",
+			connID:    0,
+			queryOnly: true,
+			wantQuery: "",
+			wantErr:   true,
+		},
+		{
+			name:      "negative connection ID",
+			connID:    -1,
+			queryOnly: true,
+			wantQuery: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildKillQuery(tt.connID, tt.queryOnly)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestShutdownError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		reachable bool
+		wantErr   bool
+	}{
+		{
+			name:    "no error",
+			err:     nil,
+			wantErr: false,
+		},
+		{
+			name:    "EOF",
+			err:     io.EOF,
+			wantErr: false,
+		},
+		{
+			name:    "unexpected EOF",
+			err:     io.ErrUnexpectedEOF,
+			wantErr: false,
+		},
+		{
+			name:    "bad connection",
+			err:     driver.ErrBadConn,
+			wantErr: false,
+		},
+		{
+			name:    "invalid connection",
+			err:     mysql.ErrInvalidConn,
+			wantErr: false,
+		},
+		{
+			name:    "closed connection",
+			err:     &net.OpError{Op: "read", Net: "tcp", Err: net.ErrClosed},
+			wantErr: false,
+		},
+		{
+			name:    "connection reset",
+			err:     &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET},
+			wantErr: false,
+		},
+		{
+			name:    "wrapped EOF",
+			err:     fmt.Errorf("error executing query: %w", io.EOF),
+			wantErr: false,
+		},
+		{
+			name:      "bad connection with reachable server",
+			err:       driver.ErrBadConn,
+			reachable: true,
+			wantErr:   true,
+		},
+		{
+			name:      "EOF with reachable server",
+			err:       io.EOF,
+			reachable: true,
+			wantErr:   true,
+		},
+		{
+			name:      "server shutdown in progress with reachable server",
+			err:       &mysql.MySQLError{Number: 1053, Message: "Server shutdown in progress"},
+			reachable: true,
+			wantErr:   false,
+		},
+		{
+			name:    "server shutdown in progress",
+			err:     &mysql.MySQLError{Number: 1053, Message: "Server shutdown in progress"},
+			wantErr: false,
+		},
+		{
+			name:    "access denied",
+			err:     &mysql.MySQLError{Number: 1227, Message: "Access denied; you need (at least one of) the SHUTDOWN privilege(s)"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown error",
+			err:     errors.New("unknown error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := shutdownError(context.Background(), tt.err, func(ctx context.Context) error {
+				if tt.reachable {
+					return nil
+				}
+				return driver.ErrBadConn
+			})
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestDemote(t *testing.T) {
+	tests := []struct {
+		name      string
+		demoter   *fakeDemoter
+		wantGtid  string
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			demoter: &fakeDemoter{
+				gtid: "0-10-42",
+			},
+			wantGtid:  "0-10-42",
+			wantCalls: []string{"EnableReadOnly", "LockTablesWithReadLock", "SystemVariable(gtid_binlog_pos)", "UnlockTables"},
+			wantErr:   false,
+		},
+		{
+			name: "multiple domains",
+			demoter: &fakeDemoter{
+				gtid: "0-10-42,1-20-7",
+			},
+			wantGtid:  "0-10-42,1-20-7",
+			wantCalls: []string{"EnableReadOnly", "LockTablesWithReadLock", "SystemVariable(gtid_binlog_pos)", "UnlockTables"},
+			wantErr:   false,
+		},
+		{
+			name: "read only error",
+			demoter: &fakeDemoter{
+				errs: map[string]error{"EnableReadOnly": errors.New("access denied")},
+			},
+			wantGtid:  "",
+			wantCalls: []string{"EnableReadOnly"},
+			wantErr:   true,
+		},
+		{
+			name: "lock error",
+			demoter: &fakeDemoter{
+				errs: map[string]error{"LockTablesWithReadLock": errors.New("lock wait timeout")},
+			},
+			wantGtid:  "",
+			wantCalls: []string{"EnableReadOnly", "LockTablesWithReadLock"},
+			wantErr:   true,
+		},
+		{
+			name: "GTID error unlocks tables",
+			demoter: &fakeDemoter{
+				errs: map[string]error{"SystemVariable(gtid_binlog_pos)": errors.New("connection reset")},
+			},
+			wantGtid:  "",
+			wantCalls: []string{"EnableReadOnly", "LockTablesWithReadLock", "SystemVariable(gtid_binlog_pos)", "UnlockTables"},
+			wantErr:   true,
+		},
+		{
+			name: "unlock error",
+			demoter: &fakeDemoter{
+				gtid: "0-10-42",
+				errs: map[string]error{"UnlockTables": errors.New("connection reset")},
+			},
+			wantGtid:  "",
+			wantCalls: []string{"EnableReadOnly", "LockTablesWithReadLock", "SystemVariable(gtid_binlog_pos)", "UnlockTables"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gtid, err := demote(context.Background(), tt.demoter)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if gtid != tt.wantGtid {
+				t.Errorf("unexpected GTID, want: %s got: %s", tt.wantGtid, gtid)
+			}
+			if diff := cmp.Diff(tt.wantCalls, tt.demoter.calls); diff != "" {
+				t.Errorf("unexpected calls (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeDemoter struct {
+	gtid  string
+	errs  map[string]error
+	calls []string
+}
+
+func (d *fakeDemoter) call(name string) error {
+	d.calls = append(d.calls, name)
+	return d.errs[name]
+}
+
+func (d *fakeDemoter) EnableReadOnly(ctx context.Context) error {
+	return d.call("EnableReadOnly")
+}
+
+func (d *fakeDemoter) LockTablesWithReadLock(ctx context.Context) error {
+	return d.call("LockTablesWithReadLock")
+}
+
+func (d *fakeDemoter) UnlockTables(ctx context.Context) error {
+	return d.call("UnlockTables")
+}
+
+func (d *fakeDemoter) SystemVariable(ctx context.Context, variable string) (string, error) {
+	if err := d.call(fmt.Sprintf("SystemVariable(%s)", variable)); err != nil {
+		return "", err
+	}
+	return d.gtid, nil
+}
+
+func TestStatusVariableHelpers(t *testing.T) {
+	statusVariables := map[string]int{
+		"uptime":            86461,
+		"threads_connected": 12,
+		"threads_running":   3,
+	}
+	statusVariableInt := func(ctx context.Context, variable string) (int, error) {
+		val, ok := statusVariables[variable]
+		if !ok {
+			return 0, fmt.Errorf("unknown status variable '%s'", variable)
+		}
+		return val, nil
+	}
+	errStatusVariableInt := func(ctx context.Context, variable string) (int, error) {
+		return 0, errors.New("connection reset")
+	}
+	ctx := context.Background()
+
+	t.Run("uptime", func(t *testing.T) {
+		got, err := uptime(ctx, statusVariableInt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := 24*time.Hour + time.Minute + time.Second; got != want {
+			t.Errorf("unexpected uptime, want: %v got: %v", want, got)
+		}
+		if _, err := uptime(ctx, errStatusVariableInt); err == nil {
+			t.Error("expect error to have occurred, got nil")
+		}
+	})
+
+	t.Run("threads connected", func(t *testing.T) {
+		got, err := threadsConnected(ctx, statusVariableInt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != 12 {
+			t.Errorf("unexpected connected threads, want: %d got: %d", 12, got)
+		}
+		if _, err := threadsConnected(ctx, errStatusVariableInt); err == nil {
+			t.Error("expect error to have occurred, got nil")
+		}
+	})
+
+	t.Run("threads running", func(t *testing.T) {
+		got, err := threadsRunning(ctx, statusVariableInt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != 3 {
+			t.Errorf("unexpected running threads, want: %d got: %d", 3, got)
+		}
+		if _, err := threadsRunning(ctx, errStatusVariableInt); err == nil {
+			t.Error("expect error to have occurred, got nil")
+		}
+	})
+}
+
+func TestBinlogEnabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		val         string
+		err         error
+		wantEnabled bool
+		wantErr     bool
+	}{
+		{
+			name:        "enabled",
+			val:         "1",
+			wantEnabled: true,
+			wantErr:     false,
+		},
+		{
+			name:        "enabled ON",
+			val:         "ON",
+			wantEnabled: true,
+			wantErr:     false,
+		},
+		{
+			name:        "disabled",
+			val:         "0",
+			wantEnabled: false,
+			wantErr:     false,
+		},
+		{
+			name:        "disabled OFF",
+			val:         "OFF",
+			wantEnabled: false,
+			wantErr:     false,
+		},
+		{
+			name:        "unexpected value",
+			val:         "",
+			wantEnabled: false,
+			wantErr:     true,
+		},
+		{
+			name:        "error",
+			err:         errors.New("connection reset"),
+			wantEnabled: false,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			systemVariable := func(ctx context.Context, variable string) (string, error) {
+				if variable != "log_bin" {
+					return "", fmt.Errorf("unexpected system variable '%s'", variable)
+				}
+				return tt.val, tt.err
+			}
+			enabled, err := binlogEnabled(context.Background(), systemVariable)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if enabled != tt.wantEnabled {
+				t.Errorf("unexpected binlog enabled, want: %v got: %v", tt.wantEnabled, enabled)
+			}
+		})
+	}
+}
+
+func TestAssertServerID(t *testing.T) {
+	tests := []struct {
+		name         string
+		serverID     int
+		err          error
+		expected     int
+		wantErr      bool
+		wantMismatch bool
+	}{
+		{
+			name:         "matching",
+			serverID:     10,
+			expected:     10,
+			wantErr:      false,
+			wantMismatch: false,
+		},
+		{
+			name:         "mismatching",
+			serverID:     11,
+			expected:     10,
+			wantErr:      true,
+			wantMismatch: true,
+		},
+		{
+			name:         "error",
+			err:          errors.New("connection refused"),
+			expected:     10,
+			wantErr:      true,
+			wantMismatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverID := func(ctx context.Context) (int, error) {
+				return tt.serverID, tt.err
+			}
+
+			err := assertServerID(context.Background(), serverID, tt.expected)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if mismatch := errors.Is(err, ErrServerIDMismatch); mismatch != tt.wantMismatch {
+				t.Errorf("unexpected server_id mismatch, want: %v got: %v", tt.wantMismatch, mismatch)
+			}
+		})
+	}
+}
+
+func TestSetEventScheduler(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		err       error
+		wantValue string
+		wantErr   bool
+	}{
+		{
+			name:      "enabled",
+			enabled:   true,
+			wantValue: "ON",
+			wantErr:   false,
+		},
+		{
+			name:      "disabled",
+			enabled:   false,
+			wantValue: "OFF",
+			wantErr:   false,
+		},
+		{
+			name:      "error",
+			enabled:   true,
+			err:       errors.New("Event scheduler is switched off, use SET GLOBAL event_scheduler=ON to enable it."),
+			wantValue: "ON",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variable, value string
+			setSystemVariable := func(ctx context.Context, v string, val string) error {
+				variable = v
+				value = val
+				return tt.err
+			}
+
+			err := setEventScheduler(context.Background(), setSystemVariable, tt.enabled)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if variable != "event_scheduler" {
+				t.Errorf("unexpected variable, want: event_scheduler got: %s", variable)
+			}
+			if value != tt.wantValue {
+				t.Errorf("unexpected value, want: %s got: %s", tt.wantValue, value)
+			}
+		})
+	}
+}
+
+func TestScanReplicaLag(t *testing.T) {
+	columns := []string{"Connection_name", "Slave_IO_Running", "Slave_SQL_Running", "Seconds_Behind_Master", "Gtid_IO_Pos"}
+	tests := []struct {
+		name    string
+		rows    *fakeRows
+		wantLag *time.Duration
+		wantErr bool
+	}{
+		{
+			name: "caught up",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{"mariadb-operator", "Yes", "Yes", []byte("0"), "0-10-42"},
+				},
+			},
+			wantLag: ptr.To(time.Duration(0)),
+			wantErr: false,
+		},
+		{
+			name: "lagging",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{"mariadb-operator", "Yes", "Yes", []byte("42"), "0-10-42"},
+				},
+			},
+			wantLag: ptr.To(42 * time.Second),
+			wantErr: false,
+		},
+		{
+			name: "replication not running",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{"mariadb-operator", "No", "No", nil, "0-10-42"},
+				},
+			},
+			wantLag: nil,
+			wantErr: false,
+		},
+		{
+			name: "no replica status",
+			rows: &fakeRows{
+				columns: columns,
+			},
+			wantLag: nil,
+			wantErr: true,
+		},
+		{
+			name: "missing column",
+			rows: &fakeRows{
+				columns: []string{"Connection_name"},
+				rows: [][]any{
+					{"mariadb-operator"},
+				},
+			},
+			wantLag: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lag, err := scanReplicaLag(tt.rows)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantLag, lag) {
+				t.Errorf("unexpected lag, want: %v got: %v", ptr.Deref(tt.wantLag, -1), ptr.Deref(lag, -1))
+			}
+		})
+	}
+}
+
+func TestStopReplicationGracefully(t *testing.T) {
+	tests := []struct {
+		name      string
+		stopper   *fakeReplicationStopper
+		wantGtid  string
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			stopper: &fakeReplicationStopper{
+				relayLogGtid: "0-10-42",
+				gtid:         "0-10-42",
+			},
+			wantGtid: "0-10-42",
+			wantCalls: []string{
+				"StopSlaveIOThread(mariadb-operator)",
+				"relayLogGtidPos(mariadb-operator)",
+				"WaitForReplicaGtid(0-10-42)",
+				"StopSlaveSQLThread(mariadb-operator)",
+				"gtidSlavePos",
+			},
+			wantErr: false,
+		},
+		{
+			name: "SQL thread not running",
+			stopper: &fakeReplicationStopper{
+				relayLogGtid: "",
+				gtid:         "0-10-40",
+			},
+			wantGtid: "0-10-40",
+			wantCalls: []string{
+				"StopSlaveIOThread(mariadb-operator)",
+				"relayLogGtidPos(mariadb-operator)",
+				"StopSlaveSQLThread(mariadb-operator)",
+				"gtidSlavePos",
+			},
+			wantErr: false,
+		},
+		{
+			name: "IO thread error",
+			stopper: &fakeReplicationStopper{
+				errs: map[string]error{"StopSlaveIOThread(mariadb-operator)": errors.New("access denied")},
+			},
+			wantGtid: "",
+			wantCalls: []string{
+				"StopSlaveIOThread(mariadb-operator)",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wait timeout keeps SQL thread running",
+			stopper: &fakeReplicationStopper{
+				relayLogGtid: "0-10-42",
+				errs:         map[string]error{"WaitForReplicaGtid(0-10-42)": ErrWaitReplicaTimeout},
+			},
+			wantGtid: "",
+			wantCalls: []string{
+				"StopSlaveIOThread(mariadb-operator)",
+				"relayLogGtidPos(mariadb-operator)",
+				"WaitForReplicaGtid(0-10-42)",
+			},
+			wantErr: true,
+		},
+		{
+			name: "SQL thread error",
+			stopper: &fakeReplicationStopper{
+				relayLogGtid: "0-10-42",
+				errs:         map[string]error{"StopSlaveSQLThread(mariadb-operator)": errors.New("connection reset")},
+			},
+			wantGtid: "",
+			wantCalls: []string{
+				"StopSlaveIOThread(mariadb-operator)",
+				"relayLogGtidPos(mariadb-operator)",
+				"WaitForReplicaGtid(0-10-42)",
+				"StopSlaveSQLThread(mariadb-operator)",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gtid, err := stopReplicationGracefully(context.Background(), tt.stopper, "mariadb-operator", time.Second)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if gtid != tt.wantGtid {
+				t.Errorf("unexpected GTID, want: %s got: %s", tt.wantGtid, gtid)
+			}
+			if diff := cmp.Diff(tt.wantCalls, tt.stopper.calls); diff != "" {
+				t.Errorf("unexpected calls (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReplicationDrainTimeout(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		ctx         func() (context.Context, context.CancelFunc)
+		wantTimeout time.Duration
+		wantErr     bool
+	}{
+		{
+			name: "no deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			wantTimeout: defaultReplicationDrainTimeout,
+			wantErr:     false,
+		},
+		{
+			name: "deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithDeadline(context.Background(), now.Add(30*time.Second))
+			},
+			wantTimeout: 30 * time.Second,
+			wantErr:     false,
+		},
+		{
+			name: "expired deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithDeadline(context.Background(), now.Add(-time.Second))
+			},
+			wantTimeout: 0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			timeout, err := replicationDrainTimeout(ctx, now)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if timeout != tt.wantTimeout {
+				t.Errorf("unexpected timeout, want: %v got: %v", tt.wantTimeout, timeout)
+			}
+		})
+	}
+}
+
+func TestBuildWaitForReplicaGtidQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		want    string
+	}{
+		{
+			name:    "seconds",
+			timeout: 10 * time.Second,
+			want:    "SELECT MASTER_GTID_WAIT('0-10-42', 10);",
+		},
+		{
+			name:    "round up",
+			timeout: 1500 * time.Millisecond,
+			want:    "SELECT MASTER_GTID_WAIT('0-10-42', 2);",
+		},
+		{
+			name:    "under one second",
+			timeout: 200 * time.Millisecond,
+			want:    "SELECT MASTER_GTID_WAIT('0-10-42', 1);",
+		},
+		{
+			name:    "zero",
+			timeout: 0,
+			want:    "SELECT MASTER_GTID_WAIT('0-10-42', 1);",
+		},
+		{
+			name:    "negative",
+			timeout: -time.Second,
+			want:    "SELECT MASTER_GTID_WAIT('0-10-42', 1);",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildWaitForReplicaGtidQuery("0-10-42", tt.timeout); got != tt.want {
+				t.Errorf("unexpected query, want: %s got: %s", tt.want, got)
+			}
+		})
+	}
+}
+
+type fakeReplicationStopper struct {
+	relayLogGtid string
+	gtid         string
+	errs         map[string]error
+	calls        []string
+}
+
+func (s *fakeReplicationStopper) call(name string) error {
+	s.calls = append(s.calls, name)
+	return s.errs[name]
+}
+
+func (s *fakeReplicationStopper) StopSlaveIOThread(ctx context.Context, connName string) error {
+	return s.call(fmt.Sprintf("StopSlaveIOThread(%s)", connName))
+}
+
+func (s *fakeReplicationStopper) StopSlaveSQLThread(ctx context.Context, connName string) error {
+	return s.call(fmt.Sprintf("StopSlaveSQLThread(%s)", connName))
+}
+
+func (s *fakeReplicationStopper) WaitForReplicaGtid(ctx context.Context, gtid string, timeout time.Duration) error {
+	return s.call(fmt.Sprintf("WaitForReplicaGtid(%s)", gtid))
+}
+
+func (s *fakeReplicationStopper) relayLogGtidPos(ctx context.Context, connName string) (string, error) {
+	if err := s.call(fmt.Sprintf("relayLogGtidPos(%s)", connName)); err != nil {
+		return "", err
+	}
+	return s.relayLogGtid, nil
+}
+
+func (s *fakeReplicationStopper) gtidSlavePos(ctx context.Context) (string, error) {
+	if err := s.call("gtidSlavePos"); err != nil {
+		return "", err
+	}
+	return s.gtid, nil
+}
+
+func TestScanRelayLogGtidPos(t *testing.T) {
+	columns := []string{"Connection_name", "Slave_IO_Running", "Slave_SQL_Running", "Seconds_Behind_Master", "Gtid_IO_Pos"}
+	tests := []struct {
+		name     string
+		rows     *fakeRows
+		wantGtid string
+		wantErr  bool
+	}{
+		{
+			name: "SQL thread running",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{"mariadb-operator", "No", "Yes", []byte("3"), "0-10-42,1-20-7"},
+				},
+			},
+			wantGtid: "0-10-42,1-20-7",
+			wantErr:  false,
+		},
+		{
+			name: "SQL thread not running",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{"mariadb-operator", "No", "No", nil, "0-10-42"},
+				},
+			},
+			wantGtid: "",
+			wantErr:  false,
+		},
+		{
+			name: "no replica status",
+			rows: &fakeRows{
+				columns: columns,
+			},
+			wantGtid: "",
+			wantErr:  true,
+		},
+		{
+			name: "missing column",
+			rows: &fakeRows{
+				columns: []string{"Connection_name", "Slave_SQL_Running"},
+				rows: [][]any{
+					{"mariadb-operator", "Yes"},
+				},
+			},
+			wantGtid: "",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gtid, err := scanRelayLogGtidPos(tt.rows)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if gtid != tt.wantGtid {
+				t.Errorf("unexpected GTID, want: %s got: %s", tt.wantGtid, gtid)
+			}
+		})
+	}
+}
+
+func TestScanSlaveHosts(t *testing.T) {
+	columns := []string{"Server_id", "Host", "Port", "Master_id"}
+	tests := []struct {
+		name      string
+		rows      *fakeRows
+		wantHosts []SlaveHost
+		wantErr   bool
+	}{
+		{
+			name: "replicas",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{[]byte("11"), "mariadb-repl-1.mariadb-repl-internal.default.svc.cluster.local", []byte("3306"), []byte("10")},
+					{[]byte("12"), "mariadb-repl-2.mariadb-repl-internal.default.svc.cluster.local", []byte("3306"), []byte("10")},
+				},
+			},
+			wantHosts: []SlaveHost{
+				{
+					ServerID: 11,
+					Host:     "mariadb-repl-1.mariadb-repl-internal.default.svc.cluster.local",
+					Port:     3306,
+				},
+				{
+					ServerID: 12,
+					Host:     "mariadb-repl-2.mariadb-repl-internal.default.svc.cluster.local",
+					Port:     3306,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no report host",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{[]byte("11"), "", []byte("3306"), []byte("10")},
+				},
+			},
+			wantHosts: []SlaveHost{
+				{
+					ServerID: 11,
+					Host:     "",
+					Port:     3306,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no replicas",
+			rows: &fakeRows{
+				columns: columns,
+			},
+			wantHosts: nil,
+			wantErr:   false,
+		},
+		{
+			name: "invalid server id",
+			rows: &fakeRows{
+				columns: columns,
+				rows: [][]any{
+					{[]byte("foo"), "mariadb-repl-1", []byte("3306"), []byte("10")},
+				},
+			},
+			wantHosts: nil,
+			wantErr:   true,
+		},
+		{
+			name: "missing column",
+			rows: &fakeRows{
+				columns: []string{"Server_id", "Host"},
+				rows: [][]any{
+					{[]byte("11"), "mariadb-repl-1"},
+				},
+			},
+			wantHosts: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hosts, err := scanSlaveHosts(tt.rows)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantHosts, hosts); diff != "" {
+				t.Errorf("unexpected slave hosts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWaitForReplicaLag(t *testing.T) {
+	tests := []struct {
+		name      string
+		lags      []*time.Duration
+		lagErr    error
+		maxLag    time.Duration
+		timeout   time.Duration
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "caught up",
+			lags:      []*time.Duration{ptr.To(time.Duration(0))},
+			maxLag:    time.Second,
+			timeout:   time.Second,
+			wantCalls: 1,
+			wantErr:   nil,
+		},
+		{
+			name:      "catching up",
+			lags:      []*time.Duration{ptr.To(30 * time.Second), nil, ptr.To(5 * time.Second), ptr.To(time.Second)},
+			maxLag:    time.Second,
+			timeout:   time.Second,
+			wantCalls: 4,
+			wantErr:   nil,
+		},
+		{
+			name:    "timeout",
+			lags:    []*time.Duration{ptr.To(30 * time.Second)},
+			maxLag:  time.Second,
+			timeout: 50 * time.Millisecond,
+			wantErr: ErrWaitReplicaTimeout,
+		},
+		{
+			name:    "replication not running",
+			lags:    []*time.Duration{nil},
+			maxLag:  time.Second,
+			timeout: 50 * time.Millisecond,
+			wantErr: ErrWaitReplicaTimeout,
+		},
+		{
+			name:      "error",
+			lagErr:    errors.New("connection reset"),
+			maxLag:    time.Second,
+			timeout:   time.Second,
+			wantCalls: 1,
+			wantErr:   errors.New("error getting replica lag: connection reset"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			replicaLag := func(ctx context.Context) (*time.Duration, error) {
+				calls++
+				if tt.lagErr != nil {
+					return nil, tt.lagErr
+				}
+				return tt.lags[min(calls, len(tt.lags))-1], nil
+			}
+
+			err := waitForReplicaLag(context.Background(), replicaLag, tt.maxLag, tt.timeout, time.Millisecond)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatal("expect error to have occurred, got nil")
+				}
+				if !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
+					t.Errorf("unexpected error, want: %v got: %v", tt.wantErr, err)
+				}
+			}
+			if tt.wantCalls > 0 && calls != tt.wantCalls {
+				t.Errorf("unexpected calls, want: %d got: %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestWaitForGaleraClusterSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizes     []int
+		sizeErr   error
+		expected  int
+		timeout   time.Duration
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "formed",
+			sizes:     []int{3},
+			expected:  3,
+			timeout:   time.Second,
+			wantCalls: 1,
+			wantErr:   nil,
+		},
+		{
+			name:      "forming",
+			sizes:     []int{1, 2, 2, 3},
+			expected:  3,
+			timeout:   time.Second,
+			wantCalls: 4,
+			wantErr:   nil,
+		},
+		{
+			name:     "timeout",
+			sizes:    []int{2},
+			expected: 3,
+			timeout:  50 * time.Millisecond,
+			wantErr:  ErrWaitGaleraClusterSizeTimeout,
+		},
+		{
+			name:      "error",
+			sizeErr:   errors.New("connection reset"),
+			expected:  3,
+			timeout:   time.Second,
+			wantCalls: 1,
+			wantErr:   errors.New("error getting Galera cluster size: connection reset"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			clusterSize := func(ctx context.Context) (int, error) {
+				calls++
+				if tt.sizeErr != nil {
+					return 0, tt.sizeErr
+				}
+				return tt.sizes[min(calls, len(tt.sizes))-1], nil
+			}
+
+			err := waitForGaleraClusterSize(context.Background(), clusterSize, tt.expected, tt.timeout, time.Millisecond)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatal("expect error to have occurred, got nil")
+				}
+				if !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
+					t.Errorf("unexpected error, want: %v got: %v", tt.wantErr, err)
+				}
+			}
+			if tt.wantCalls > 0 && calls != tt.wantCalls {
+				t.Errorf("unexpected calls, want: %d got: %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestParseGaleraMembers(t *testing.T) {
+	tests := []struct {
+		name        string
+		addresses   string
+		wantMembers []string
+	}{
+		{
+			name:        "empty",
+			addresses:   "",
+			wantMembers: nil,
+		},
+		{
+			name:        "single member",
+			addresses:   "10.244.0.10:3306",
+			wantMembers: []string{"10.244.0.10:3306"},
+		},
+		{
+			name:      "multiple members",
+			addresses: "10.244.0.10:3306,10.244.0.11:3306,10.244.0.12:3306",
+			wantMembers: []string{
+				"10.244.0.10:3306",
+				"10.244.0.11:3306",
+				"10.244.0.12:3306",
+			},
+		},
+		{
+			name:      "members without published address",
+			addresses: "10.244.0.10:3306,,AUTO, 10.244.0.12:3306 ",
+			wantMembers: []string{
+				"10.244.0.10:3306",
+				"10.244.0.12:3306",
+			},
+		},
+		{
+			name:      "IPv6 members",
+			addresses: "[fd00::10]:3306,[fd00::11]:3306",
+			wantMembers: []string{
+				"[fd00::10]:3306",
+				"[fd00::11]:3306",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := parseGaleraMembers(tt.addresses)
+			if diff := cmp.Diff(tt.wantMembers, members); diff != "" {
+				t.Errorf("unexpected members (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGaleraMembers(t *testing.T) {
+	tests := []struct {
+		name        string
+		variables   map[string]string
+		err         error
+		wantMembers []string
+		wantErr     bool
+	}{
+		{
+			name: "primary",
+			variables: map[string]string{
+				"wsrep_cluster_status":     "Primary",
+				"wsrep_incoming_addresses": "10.244.0.10:3306,10.244.0.11:3306,10.244.0.12:3306",
+			},
+			wantMembers: []string{
+				"10.244.0.10:3306",
+				"10.244.0.11:3306",
+				"10.244.0.12:3306",
+			},
+			wantErr: false,
+		},
+		{
+			name: "primary without addresses",
+			variables: map[string]string{
+				"wsrep_cluster_status":     "Primary",
+				"wsrep_incoming_addresses": "",
+			},
+			wantMembers: nil,
+			wantErr:     false,
+		},
+		{
+			name: "partitioned",
+			variables: map[string]string{
+				"wsrep_cluster_status":     "non-Primary",
+				"wsrep_incoming_addresses": "10.244.0.10:3306",
+			},
+			wantMembers: nil,
+			wantErr:     true,
+		},
+		{
+			name: "disconnected",
+			variables: map[string]string{
+				"wsrep_cluster_status":     "Disconnected",
+				"wsrep_incoming_addresses": "",
+			},
+			wantMembers: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "error",
+			err:         errors.New("connection refused"),
+			wantMembers: nil,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusVariable := func(ctx context.Context, variable string) (string, error) {
+				if tt.err != nil {
+					return "", tt.err
+				}
+				return tt.variables[variable], nil
+			}
+
+			members, err := galeraMembers(context.Background(), statusVariable)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantMembers, members); diff != "" {
+				t.Errorf("unexpected members (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGaleraLocalStateNumber(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      int
+		err        error
+		wantState  GaleraNodeState
+		wantString string
+		wantErr    bool
+	}{
+		{
+			name:       "initialized",
+			value:      0,
+			wantState:  GaleraNodeStateInitialized,
+			wantString: "Initialized",
+		},
+		{
+			name:       "joining",
+			value:      1,
+			wantState:  GaleraNodeStateJoining,
+			wantString: "Joining",
+		},
+		{
+			name:       "donor",
+			value:      2,
+			wantState:  GaleraNodeStateDonor,
+			wantString: "Donor/Desynced",
+		},
+		{
+			name:       "joined",
+			value:      3,
+			wantState:  GaleraNodeStateJoined,
+			wantString: "Joined",
+		},
+		{
+			name:       "synced",
+			value:      4,
+			wantState:  GaleraNodeStateSynced,
+			wantString: "Synced",
+		},
+		{
+			name:    "unknown",
+			value:   5,
+			wantErr: true,
+		},
+		{
+			name:    "negative",
+			value:   -1,
+			wantErr: true,
+		},
+		{
+			name:    "error",
+			err:     errors.New("connection refused"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusVariableInt := func(ctx context.Context, variable string) (int, error) {
+				if variable != "wsrep_local_state" {
+					t.Fatalf("unexpected variable: %s", variable)
+				}
+				return tt.value, tt.err
+			}
+
+			state, err := galeraLocalStateNumber(context.Background(), statusVariableInt)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expect error to have occurred, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if state != tt.wantState {
+				t.Errorf("unexpected state, want: %d got: %d", tt.wantState, state)
+			}
+			if s := state.String(); s != tt.wantString {
+				t.Errorf("unexpected state string, want: %s got: %s", tt.wantString, s)
+			}
+		})
+	}
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]any
+	idx     int
+	err     error
+}
+
+func (r *fakeRows) Columns() ([]string, error) {
+	return r.columns, nil
+}
+
+func (r *fakeRows) Next() bool {
+	if r.idx >= len(r.rows) {
+		return false
+	}
+	r.idx++
+	return true
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	row := r.rows[r.idx-1]
+	if len(dest) != len(row) {
+		return fmt.Errorf("expected %d destination arguments, got %d", len(row), len(dest))
+	}
+	for i, d := range dest {
+		if scanner, ok := d.(sql.Scanner); ok {
+			if err := scanner.Scan(row[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		dv := reflect.ValueOf(d)
+		if dv.Kind() != reflect.Pointer {
+			return fmt.Errorf("destination argument %d is not a pointer", i)
+		}
+		dv.Elem().Set(reflect.ValueOf(row[i]))
+	}
+	return nil
+}
+
+func (r *fakeRows) Err() error {
+	return r.err
+}
+
+func TestBuildGrantQuery(t *testing.T) {
+	account := NewAccount("bob", "%")
+	tests := []struct {
+		name       string
+		privileges []string
+		database   string
+		table      string
+		opts       []GrantOption
+		wantQuery  string
+		wantErr    bool
+	}{
+		{
+			name:       "default scope",
+			privileges: []string{"SELECT", "INSERT"},
+			database:   "mariadb",
+			table:      "*",
+			wantQuery:  "GRANT SELECT,INSERT ON `mariadb`.* TO 'bob'@'%' ;",
+			wantErr:    false,
+		},
+		{
+			name:       "default scope wildcards",
+			privileges: []string{"ALL PRIVILEGES"},
+			database:   "*",
+			table:      "*",
+			opts:       []GrantOption{WithGrantOption()},
+			wantQuery:  "GRANT ALL PRIVILEGES ON *.* TO 'bob'@'%' WITH GRANT OPTION ;",
+			wantErr:    false,
+		},
+		{
+			name:       "global",
+			privileges: []string{"PROCESS", "REPLICATION CLIENT"},
+			database:   "mariadb",
+			table:      "users",
+			opts:       []GrantOption{WithGrantScope(GrantScopeGlobal)},
+			wantQuery:  "GRANT PROCESS,REPLICATION CLIENT ON *.* TO 'bob'@'%' ;",
+			wantErr:    false,
+		},
+		{
+			name:       "database",
+			privileges: []string{"SELECT"},
+			database:   "mariadb",
+			opts:       []GrantOption{WithGrantScope(GrantScopeDatabase)},
+			wantQuery:  "GRANT SELECT ON `mariadb`.* TO 'bob'@'%' ;",
+			wantErr:    false,
+		},
+		{
+			name:       "database without name",
+			privileges: []string{"SELECT"},
+			database:   "*",
+			opts:       []GrantOption{WithGrantScope(GrantScopeDatabase)},
+			wantQuery:  "",
+			wantErr:    true,
+		},
+		{
+			name:       "table",
+			privileges: []string{"SELECT", "UPDATE (name)"},
+			database:   "mariadb",
+			table:      "users",
+			opts:       []GrantOption{WithGrantScope(GrantScopeTable)},
+			wantQuery:  "GRANT SELECT,UPDATE (`name`) ON `mariadb`.`users` TO 'bob'@'%' ;",
+			wantErr:    false,
+		},
+		{
+			name:       "table with wildcard",
+			privileges: []string{"SELECT"},
+			database:   "mariadb",
+			table:      "*",
+			opts:       []GrantOption{WithGrantScope(GrantScopeTable)},
+			wantQuery:  "",
+			wantErr:    true,
+		},
+		{
+			name:       "function",
+			privileges: []string{"EXECUTE"},
+			database:   "mariadb",
+			table:      "total_orders",
+			opts:       []GrantOption{WithGrantScope(GrantScopeFunction)},
+			wantQuery:  "GRANT EXECUTE ON FUNCTION `mariadb`.`total_orders` TO 'bob'@'%' ;",
+			wantErr:    false,
+		},
+		{
+			name:       "procedure",
+			privileges: []string{"EXECUTE", "ALTER ROUTINE"},
+			database:   "mariadb",
+			table:      "archive_orders",
+			opts:       []GrantOption{WithGrantScope(GrantScopeProcedure), WithGrantOption()},
+			wantQuery:  "GRANT EXECUTE,ALTER ROUTINE ON PROCEDURE `mariadb`.`archive_orders` TO 'bob'@'%' WITH GRANT OPTION ;",
+			wantErr:    false,
+		},
+		{
+			name:       "procedure without name",
+			privileges: []string{"EXECUTE"},
+			database:   "mariadb",
+			table:      "",
+			opts:       []GrantOption{WithGrantScope(GrantScopeProcedure)},
+			wantQuery:  "",
+			wantErr:    true,
+		},
+		{
+			name:       "identifier quoting",
+			privileges: []string{"EXECUTE"},
+			database:   "maria`db",
+			table:      "proc`; DROP DATABASE `mysql",
+			opts:       []GrantOption{WithGrantScope(GrantScopeProcedure)},
+			wantQuery:  "GRANT EXECUTE ON PROCEDURE `maria``db`.`proc``; DROP DATABASE ``mysql` TO 'bob'@'%' ;",
+			wantErr:    false,
+		},
+		{
+			name:       "unsupported scope",
+			privileges: []string{"SELECT"},
+			database:   "mariadb",
+			table:      "users",
+			opts:       []GrantOption{WithGrantScope("column")},
+			wantQuery:  "",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid privileges",
+			privileges: []string{"SELCT"},
+			database:   "mariadb",
+			table:      "users",
+			wantQuery:  "",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildGrantQuery(tt.privileges, tt.database, tt.table, account, tt.opts...)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildRevokeQuery(t *testing.T) {
+	account := NewAccount("bob", "%")
+	tests := []struct {
+		name       string
+		privileges []string
+		database   string
+		table      string
+		opts       []GrantOption
+		wantQuery  string
+		wantErr    bool
+	}{
+		{
+			name:       "default scope",
+			privileges: []string{"SELECT"},
+			database:   "mariadb",
+			table:      "users",
+			wantQuery:  "REVOKE SELECT ON `mariadb`.`users` FROM 'bob'@'%'",
+			wantErr:    false,
+		},
+		{
+			name:       "global with grant option",
+			privileges: []string{"ALL PRIVILEGES"},
+			opts:       []GrantOption{WithGrantScope(GrantScopeGlobal), WithGrantOption()},
+			wantQuery:  "REVOKE ALL PRIVILEGES,GRANT OPTION ON *.* FROM 'bob'@'%'",
+			wantErr:    false,
+		},
+		{
+			name:       "database",
+			privileges: []string{"SELECT"},
+			database:   "mariadb",
+			opts:       []GrantOption{WithGrantScope(GrantScopeDatabase)},
+			wantQuery:  "REVOKE SELECT ON `mariadb`.* FROM 'bob'@'%'",
+			wantErr:    false,
+		},
+		{
+			name:       "function",
+			privileges: []string{"EXECUTE"},
+			database:   "mariadb",
+			table:      "total_orders",
+			opts:       []GrantOption{WithGrantScope(GrantScopeFunction)},
+			wantQuery:  "REVOKE EXECUTE ON FUNCTION `mariadb`.`total_orders` FROM 'bob'@'%'",
+			wantErr:    false,
+		},
+		{
+			name:       "procedure",
+			privileges: []string{"EXECUTE"},
+			database:   "mariadb",
+			table:      "archive_orders",
+			opts:       []GrantOption{WithGrantScope(GrantScopeProcedure)},
+			wantQuery:  "REVOKE EXECUTE ON PROCEDURE `mariadb`.`archive_orders` FROM 'bob'@'%'",
+			wantErr:    false,
+		},
+		{
+			name:       "function without database",
+			privileges: []string{"EXECUTE"},
+			database:   "",
+			table:      "total_orders",
+			opts:       []GrantOption{WithGrantScope(GrantScopeFunction)},
+			wantQuery:  "",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotQuery, err := buildRevokeQuery(tt.privileges, tt.database, tt.table, account, tt.opts...)
+			if tt.wantErr && err == nil {
+				t.Error("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expect error to not have occurred, got: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDropDatabase(t *testing.T) {
+	tests := []struct {
+		name        string
+		tables      int
+		countErr    error
+		opts        []DropDatabaseOpt
+		wantQueries []string
+		wantErr     error
+	}{
+		{
+			name:        "empty database",
+			tables:      0,
+			opts:        nil,
+			wantQueries: []string{"DROP DATABASE IF EXISTS `mariadb`;"},
+			wantErr:     nil,
+		},
+		{
+			name:        "non empty database",
+			tables:      3,
+			opts:        nil,
+			wantQueries: []string{"DROP DATABASE IF EXISTS `mariadb`;"},
+			wantErr:     nil,
+		},
+		{
+			name:        "empty database fail if not empty",
+			tables:      0,
+			opts:        []DropDatabaseOpt{WithFailIfNotEmpty()},
+			wantQueries: []string{"DROP DATABASE IF EXISTS `mariadb`;"},
+			wantErr:     nil,
+		},
+		{
+			name:        "non empty database fail if not empty",
+			tables:      3,
+			opts:        []DropDatabaseOpt{WithFailIfNotEmpty()},
+			wantQueries: nil,
+			wantErr:     ErrDatabaseNotEmpty,
+		},
+		{
+			name:        "count error",
+			countErr:    errors.New("connection reset"),
+			opts:        []DropDatabaseOpt{WithFailIfNotEmpty()},
+			wantQueries: nil,
+			wantErr:     errors.New("error counting tables: connection reset"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dropper := &fakeDatabaseDropper{
+				tables:   tt.tables,
+				countErr: tt.countErr,
+			}
+			err := dropDatabase(context.Background(), dropper, "mariadb", tt.opts...)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatal("expect error to have occurred, got nil")
+				}
+				if !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
+					t.Errorf("unexpected error, want: %v got: %v", tt.wantErr, err)
+				}
+			}
+			if diff := cmp.Diff(tt.wantQueries, dropper.queries); diff != "" {
+				t.Errorf("unexpected queries (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeDatabaseDropper struct {
+	tables   int
+	countErr error
+	queries  []string
+}
+
+func (d *fakeDatabaseDropper) DatabaseTableCount(ctx context.Context, database string) (int, error) {
+	return d.tables, d.countErr
+}
+
+func (d *fakeDatabaseDropper) Exec(ctx context.Context, sql string, args ...any) error {
+	d.queries = append(d.queries, sql)
+	return nil
+}
+
+func TestAlterUserNeeded(t *testing.T) {
+	hash := "*57685B4F0FF9D049082E296E2C39354B7A98774E"
+	tests := []struct {
+		name       string
+		state      *UserState
+		options    []CreateUserOpt
+		wantNeeded bool
+	}{
+		{
+			name: "password is always applied",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedBy("MariaDB11!"),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "same password hash",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				MaxUserConnections:   10,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithMaxUserConnections(10),
+			},
+			wantNeeded: false,
+		},
+		{
+			name: "different password hash",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: "*6C8989366EAF75BB670AD8EA7A7FC1176A95CEF4",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "same plugin as value",
+			state: &UserState{
+				Plugin:               "ed25519",
+				AuthenticationString: "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519", "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantNeeded: false,
+		},
+		{
+			name: "different plugin as value",
+			state: &UserState{
+				Plugin:               "ed25519",
+				AuthenticationString: "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedViaAs("ed25519", "4LH+dBF+G5W2CKTyId8xR3SyDqZoQjUNUVNxx8aWbG4"),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "password hash with different plugin",
+			state: &UserState{
+				Plugin:               "ed25519",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "same plugin",
+			state: &UserState{
+				Plugin:               "ed25519",
+				AuthenticationString: "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedVia("ed25519"),
+				WithIdentifiedViaUsing("ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY"),
+			},
+			wantNeeded: false,
+		},
+		{
+			name: "different plugin",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedVia("pam"),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "different plugin argument",
+			state: &UserState{
+				Plugin:               "pam",
+				AuthenticationString: "mariadb",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedVia("pam"),
+				WithIdentifiedViaUsing("mariadb-ldap"),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "different max user connections",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				MaxUserConnections:   10,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithMaxUserConnections(20),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "same TLS requirements",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				SSLType:              "SPECIFIED",
+				X509Issuer:           "/CN=mariadb-galera-ca",
+				X509Subject:          "/CN=mariadb-galera-client",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{
+					Issuer:  ptr.To("/CN=mariadb-galera-ca"),
+					Subject: ptr.To("/CN=mariadb-galera-client"),
+				}),
+			},
+			wantNeeded: false,
+		},
+		{
+			name: "same X509 requirement",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				SSLType:              "X509",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{
+					X509: ptr.To(true),
+				}),
+			},
+			wantNeeded: false,
+		},
+		{
+			name: "different TLS requirements",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				SSLType:              "ANY",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithTLSRequirements(&mariadbv1alpha1.TLSRequirements{
+					X509: ptr.To(true),
+				}),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "require none with X509 requirement",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				SSLType:              "X509",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithRequireNone(),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "require none with issuer requirement",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				SSLType:              "SPECIFIED",
+				X509Issuer:           "/CN=mariadb-galera-ca",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithRequireNone(),
+			},
+			wantNeeded: true,
+		},
+		{
+			name: "require none without requirements",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+				WithRequireNone(),
+			},
+			wantNeeded: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := CreateUserOpts{}
+			for _, setOpt := range tt.options {
+				setOpt(&opts)
+			}
+			if needed := alterUserNeeded(tt.state, &opts); needed != tt.wantNeeded {
+				t.Errorf("unexpected alter user needed, want: %v got: %v", tt.wantNeeded, needed)
+			}
+		})
+	}
+}
+
+func TestUserDiffers(t *testing.T) {
+	hash := "*57685B4F0FF9D049082E296E2C39354B7A98774E"
+	tests := []struct {
+		name        string
+		state       *UserState
+		options     []CreateUserOpt
+		wantDiffers bool
+	}{
+		{
+			name: "same password",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedBy("MariaDB11!"),
+			},
+			wantDiffers: false,
+		},
+		{
+			name: "different password",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedBy("another-password"),
+			},
+			wantDiffers: true,
+		},
+		{
+			name: "password with different plugin",
+			state: &UserState{
+				Plugin:               "ed25519",
+				AuthenticationString: "ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedBy("MariaDB11!"),
+			},
+			wantDiffers: true,
+		},
+		{
+			name: "same password with different max connections",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+				MaxUserConnections:   10,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedBy("MariaDB11!"),
+				WithMaxUserConnections(20),
+			},
+			wantDiffers: true,
+		},
+		{
+			name: "same password hash",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedByPassword(hash),
+			},
+			wantDiffers: false,
+		},
+		{
+			name: "same plugin",
+			state: &UserState{
+				Plugin:               "unix_socket",
+				AuthenticationString: "",
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedVia("unix_socket"),
+			},
+			wantDiffers: false,
+		},
+		{
+			name: "different plugin",
+			state: &UserState{
+				Plugin:               "mysql_native_password",
+				AuthenticationString: hash,
+			},
+			options: []CreateUserOpt{
+				WithIdentifiedVia("unix_socket"),
+			},
+			wantDiffers: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := CreateUserOpts{}
+			for _, setOpt := range tt.options {
+				setOpt(&opts)
+			}
+			if differs := userDiffers(tt.state, &opts); differs != tt.wantDiffers {
+				t.Errorf("unexpected user differs, want: %v got: %v", tt.wantDiffers, differs)
+			}
+		})
+	}
+}
+
+func TestNativePasswordHash(t *testing.T) {
+	tests := []struct {
+		password string
+		wantHash string
+	}{
+		{
+			password: "password",
+			wantHash: "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19",
+		},
+		{
+			password: "MariaDB11!",
+			wantHash: "*57685B4F0FF9D049082E296E2C39354B7A98774E",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			if hash := nativePasswordHash(tt.password); hash != tt.wantHash {
+				t.Errorf("unexpected hash, want: %s got: %s", tt.wantHash, hash)
+			}
+		})
+	}
+}
+
+func TestWithParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []map[string]string
+		wantParams map[string]string
+		wantDSN    string
+	}{
+		{
+			name:       "no params",
+			params:     nil,
+			wantParams: nil,
+			wantDSN:    "tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s",
+		},
+		{
+			name: "single param set",
+			params: []map[string]string{
+				{"sql_mode": "'STRICT_TRANS_TABLES'"},
+			},
+			wantParams: map[string]string{
+				"sql_mode": "'STRICT_TRANS_TABLES'",
+			},
+			wantDSN: "tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&sql_mode=%27STRICT_TRANS_TABLES%27",
+		},
+		{
+			name: "merge two param sets",
+			params: []map[string]string{
+				{"sql_mode": "'STRICT_TRANS_TABLES'"},
+				{"time_zone": "'+00:00'"},
+			},
+			wantParams: map[string]string{
+				"sql_mode":  "'STRICT_TRANS_TABLES'",
+				"time_zone": "'+00:00'",
+			},
+			wantDSN: "tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&sql_mode=%27STRICT_TRANS_TABLES%27&time_zone=%27%2B00%3A00%27",
+		},
+		{
+			name: "later param set overrides",
+			params: []map[string]string{
+				{"sql_mode": "'STRICT_TRANS_TABLES'", "time_zone": "'+00:00'"},
+				{"sql_mode": "'ANSI'"},
+			},
+			wantParams: map[string]string{
+				"sql_mode":  "'ANSI'",
+				"time_zone": "'+00:00'",
+			},
+			wantDSN: "tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&sql_mode=%27ANSI%27&time_zone=%27%2B00%3A00%27",
+		},
+		{
+			name: "empty param set",
+			params: []map[string]string{
+				{"sql_mode": "'STRICT_TRANS_TABLES'"},
+				{},
+			},
+			wantParams: map[string]string{
+				"sql_mode": "'STRICT_TRANS_TABLES'",
+			},
+			wantDSN: "tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s&sql_mode=%27STRICT_TRANS_TABLES%27",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Opts{
+				Host: "mariadb-0.mariadb-internal",
+				Port: 3306,
+			}
+			for _, p := range tt.params {
+				WithParams(p)(&opts)
+			}
+			if diff := cmp.Diff(tt.wantParams, opts.Params); diff != "" {
+				t.Errorf("unexpected params (-want +got):\n%s", diff)
+			}
+
+			dsn, err := BuildDSN(opts)
+			if err != nil {
+				t.Fatalf("unexpected error building DSN: %v", err)
+			}
+			if dsn != tt.wantDSN {
+				t.Errorf("unexpected DSN, want: %s got: %s", tt.wantDSN, dsn)
+			}
+		})
+	}
+}
+
+func TestWithParamsDoesNotMutateInput(t *testing.T) {
+	first := map[string]string{"sql_mode": "'STRICT_TRANS_TABLES'"}
+	second := map[string]string{"time_zone": "'+00:00'"}
+
+	opts := Opts{}
+	WithParams(first)(&opts)
+	WithParams(second)(&opts)
+
+	if diff := cmp.Diff(map[string]string{"sql_mode": "'STRICT_TRANS_TABLES'"}, first); diff != "" {
+		t.Errorf("unexpected mutation of first params (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"time_zone": "'+00:00'"}, second); diff != "" {
+		t.Errorf("unexpected mutation of second params (-want +got):\n%s", diff)
+	}
+}
+
+func TestCredentialProviderHook(t *testing.T) {
+	passwords := []string{"MariaDB11!", "MariaDB11!-rotated"}
+	calls := 0
+	provider := func(ctx context.Context) (string, error) {
+		password := passwords[calls%len(passwords)]
+		calls++
+		return password, nil
+	}
+	hook := credentialProviderHook(provider)
+
+	config, err := mysql.ParseDSN("root:stale@tcp(mariadb-0.mariadb-internal:3306)/?timeout=5s")
+	if err != nil {
+		t.Fatalf("unexpected error parsing DSN: %v", err)
+	}
+
+	for i, wantPassword := range []string{"MariaDB11!", "MariaDB11!-rotated", "MariaDB11!"} {
+		connConfig := config.Clone()
+		if err := hook(context.Background(), connConfig); err != nil {
+			t.Fatalf("unexpected error in connection %d: %v", i, err)
+		}
+		if connConfig.Passwd != wantPassword {
+			t.Errorf("unexpected password in connection %d, want: %s got: %s", i, wantPassword, connConfig.Passwd)
+		}
+		if connConfig.User != "root" {
+			t.Errorf("unexpected user in connection %d: %s", i, connConfig.User)
+		}
+	}
+	if config.Passwd != "stale" {
+		t.Errorf("expected base config to be unchanged, got password: %s", config.Passwd)
+	}
+	if calls != 3 {
+		t.Errorf("expected provider to be called once per connection, got: %d calls", calls)
+	}
+}
+
+func TestCredentialProviderHookError(t *testing.T) {
+	hook := credentialProviderHook(func(ctx context.Context) (string, error) {
+		return "", errors.New("secret not found")
+	})
+	config := mysql.NewConfig()
+	config.Passwd = "stale"
+
+	if err := hook(context.Background(), config); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if config.Passwd != "stale" {
+		t.Errorf("expected password to be unchanged on error, got: %s", config.Passwd)
+	}
+}
+
+func TestConnectFn(t *testing.T) {
+	if fn := connectFn(Opts{}); reflect.ValueOf(fn).Pointer() != reflect.ValueOf(Connect).Pointer() {
+		t.Error("expected Connect to be used without credential provider")
+	}
+	opts := Opts{}
+	WithCredentialProvider(func(ctx context.Context) (string, error) {
+		return "MariaDB11!", nil
+	})(&opts)
+	if opts.CredentialProvider == nil {
+		t.Fatal("expected credential provider to be set")
+	}
+	if fn := connectFn(opts); reflect.ValueOf(fn).Pointer() == reflect.ValueOf(Connect).Pointer() {
+		t.Error("expected credential provider connection to be used")
+	}
+}
+
+func TestConnectWithCredentialProvider(t *testing.T) {
+	calls := 0
+	provider := func(ctx context.Context) (string, error) {
+		calls++
+		return "", errors.New("secret not found")
+	}
+
+	if _, err := ConnectWithCredentialProvider("invalid-dsn", provider); err == nil {
+		t.Error("expected error parsing DSN, got nil")
+	}
+	if calls != 0 {
+		t.Errorf("expected provider not to be called with an invalid DSN, got: %d calls", calls)
+	}
+
+	if _, err := ConnectWithCredentialProvider("root@tcp(127.0.0.1:1)/?timeout=1s", provider); err == nil {
+		t.Error("expected error connecting, got nil")
+	}
+	if calls == 0 {
+		t.Error("expected provider to be called when connecting")
+	}
+}
+
+func TestBuildRevokeAllQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		accountName string
+		wantQuery   string
+		wantErr     bool
+	}{
+		{
+			name:        "user",
+			accountName: "bob",
+			wantQuery:   "REVOKE ALL PRIVILEGES, GRANT OPTION FROM 'bob'@'%';",
+			wantErr:     false,
+		},
+		{
+			name:        "user and host",
+			accountName: "'bob'@'10.244.%'",
+			wantQuery:   "REVOKE ALL PRIVILEGES, GRANT OPTION FROM 'bob'@'10.244.%';",
+			wantErr:     false,
+		},
+		{
+			name:        "embedded quote",
+			accountName: "'o''brien'@'localhost'",
+			wantQuery:   "REVOKE ALL PRIVILEGES, GRANT OPTION FROM 'o''brien'@'localhost';",
+			wantErr:     false,
+		},
+		{
+			name:        "injection attempt",
+			accountName: "'bob'@'%'; DROP USER root",
+			wantQuery:   "",
+			wantErr:     true,
+		},
+		{
+			name:        "empty",
+			accountName: "",
+			wantQuery:   "",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := ParseAccount(tt.accountName)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query := buildRevokeAllQuery(account); query != tt.wantQuery {
+				t.Errorf("unexpected query, want: %s got: %s", tt.wantQuery, query)
+			}
+		})
+	}
+}
+
+func TestAdminStatementsTimeout(t *testing.T) {
+	db := sql.OpenDB(&slowConnector{})
+	defer db.Close()
+
+	tests := []struct {
+		name string
+		exec func(ctx context.Context, c *Client) error
+	}{
+		{
+			name: "lock tables",
+			exec: func(ctx context.Context, c *Client) error {
+				return c.LockTablesWithReadLock(ctx)
+			},
+		},
+		{
+			name: "unlock tables",
+			exec: func(ctx context.Context, c *Client) error {
+				return c.UnlockTables(ctx)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{
+				db:           db,
+				adminTimeout: 50 * time.Millisecond,
+			}
+			done := make(chan error, 1)
+			go func() {
+				done <- tt.exec(context.Background(), client)
+			}()
+
+			select {
+			case err := <-done:
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("unexpected error, want: %v got: %v", context.DeadlineExceeded, err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected admin statement to time out")
+			}
+		})
+	}
+}
+
+func TestAdminContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeout     time.Duration
+		maxDeadline time.Duration
+	}{
+		{
+			name:        "custom timeout",
+			timeout:     time.Second,
+			maxDeadline: time.Second,
+		},
+		{
+			name:        "zero timeout",
+			timeout:     0,
+			maxDeadline: defaultAdminTimeout,
+		},
+		{
+			name:        "negative timeout",
+			timeout:     -time.Second,
+			maxDeadline: defaultAdminTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := adminContext(context.Background(), tt.timeout)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("expected context to have a deadline")
+			}
+			remaining := time.Until(deadline)
+			if remaining <= 0 || remaining > tt.maxDeadline {
+				t.Errorf("unexpected deadline, want at most: %v got: %v", tt.maxDeadline, remaining)
+			}
+		})
+	}
+}
+
+func TestWithAdminTimeout(t *testing.T) {
+	opts := Opts{}
+	WithAdminTimeout(10 * time.Second)(&opts)
+
+	if opts.AdminTimeout == nil || *opts.AdminTimeout != 10*time.Second {
+		t.Errorf("unexpected admin timeout, want: %v got: %v", 10*time.Second, opts.AdminTimeout)
+	}
+}
+
+// slowConnector returns connections whose statements block until the context is done, simulating a hung server.
+type slowConnector struct{}
+
+func (c *slowConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &slowConn{}, nil
+}
+
+func (c *slowConnector) Driver() driver.Driver {
+	return nil
+}
+
+type slowConn struct{}
+
+func (c *slowConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (c *slowConn) Close() error {
+	return nil
+}
+
+func (c *slowConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+func (c *slowConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestWithHostOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Opt
+		wantHost string
+	}{
+		{
+			name: "override after default host",
+			options: []Opt{
+				WitHost("mariadb-0.mariadb-internal.default.svc.cluster.local"),
+				WithHostOverride("10.244.0.12"),
+			},
+			wantHost: "10.244.0.12:3306",
+		},
+		{
+			name: "override before default host",
+			options: []Opt{
+				WithHostOverride("10.244.0.12"),
+				WitHost("mariadb-0.mariadb-internal.default.svc.cluster.local"),
+			},
+			wantHost: "10.244.0.12:3306",
+		},
+		{
+			name: "no override",
+			options: []Opt{
+				WitHost("mariadb-0.mariadb-internal.default.svc.cluster.local"),
+			},
+			wantHost: "mariadb-0.mariadb-internal.default.svc.cluster.local:3306",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Opts{
+				Port: 3306,
+			}
+			for _, setOpt := range tt.options {
+				setOpt(&opts)
+			}
+			dsn, err := BuildDSN(opts)
+			if err != nil {
+				t.Fatalf("unexpected error building DSN: %v", err)
+			}
+			config, err := mysql.ParseDSN(dsn)
+			if err != nil {
+				t.Fatalf("unexpected error parsing DSN: %v", err)
+			}
+			if config.Addr != tt.wantHost {
+				t.Errorf("unexpected host, want: %s got: %s", tt.wantHost, config.Addr)
+			}
+		})
+	}
+}
+
+func TestWithSessionWaitTimeout(t *testing.T) {
+	params := map[string]string{
+		"autocommit": "1",
+	}
+	opts := Opts{
+		Host:   "mariadb-0.mariadb-internal",
+		Port:   3306,
+		Params: params,
+	}
+	WithSessionWaitTimeout(600)(&opts)
+
+	dsn, err := BuildDSN(opts)
+	if err != nil {
+		t.Fatalf("unexpected error building DSN: %v", err)
+	}
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("unexpected error parsing DSN: %v", err)
+	}
+	if waitTimeout := config.Params["wait_timeout"]; waitTimeout != "600" {
+		t.Errorf("unexpected wait_timeout session variable, want: 600 got: %s", waitTimeout)
+	}
+	if _, ok := params["wait_timeout"]; ok {
+		t.Error("expected params not to be modified")
+	}
+	if maxIdleTime := sessionMaxIdleTime(600); maxIdleTime != 5*time.Minute {
+		t.Errorf("unexpected max idle time, want: %v got: %v", 5*time.Minute, maxIdleTime)
+	}
+}
+
+func TestWithMaxAllowedPacket(t *testing.T) {
+	opts := Opts{}
+	WithMaxAllowedPacket(32 << 20)(&opts)
+
+	if opts.MaxAllowedPacket == nil || *opts.MaxAllowedPacket != 32<<20 {
+		t.Errorf("unexpected max allowed packet, want: %v got: %v", 32<<20, opts.MaxAllowedPacket)
+	}
+
+	dsn, err := BuildDSN(Opts{
+		Host:             "mariadb-0.mariadb-internal",
+		Port:             3306,
+		MaxAllowedPacket: opts.MaxAllowedPacket,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error building DSN: %v", err)
+	}
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("unexpected error parsing DSN: %v", err)
+	}
+	if config.MaxAllowedPacket != 32<<20 {
+		t.Errorf("unexpected max allowed packet in DSN, want: %v got: %v", 32<<20, config.MaxAllowedPacket)
+	}
+}
+
+func TestBuildSetGaleraDonorQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		donors    []string
+		opts      []GaleraDonorOpt
 		wantQuery string
 		wantErr   bool
 	}{
 		{
-			name: "missing host",
-			options: []ChangeMasterOpt{
-				WithChangeMasterPort(3306),
-				WithChangeMasterCredentials("repl", "password"),
-			},
+			name:      "no donors",
+			donors:    nil,
+			wantQuery: "SET @@global.wsrep_sst_donor='';",
+			wantErr:   false,
+		},
+		{
+			name:      "no donors with fallback",
+			donors:    nil,
+			opts:      []GaleraDonorOpt{WithGaleraDonorFallback()},
+			wantQuery: "SET @@global.wsrep_sst_donor='';",
+			wantErr:   false,
+		},
+		{
+			name:      "single donor",
+			donors:    []string{"mariadb-galera-1"},
+			wantQuery: "SET @@global.wsrep_sst_donor='mariadb-galera-1';",
+			wantErr:   false,
+		},
+		{
+			name:      "multiple donors",
+			donors:    []string{"mariadb-galera-1", "mariadb-galera-2"},
+			wantQuery: "SET @@global.wsrep_sst_donor='mariadb-galera-1,mariadb-galera-2';",
+			wantErr:   false,
+		},
+		{
+			name:      "multiple donors with fallback",
+			donors:    []string{"mariadb-galera-1", "mariadb-galera-2"},
+			opts:      []GaleraDonorOpt{WithGaleraDonorFallback()},
+			wantQuery: "SET @@global.wsrep_sst_donor='mariadb-galera-1,mariadb-galera-2,';",
+			wantErr:   false,
+		},
+		{
+			name:      "quoted donor",
+			donors:    []string{"o'brien"},
+			wantQuery: "SET @@global.wsrep_sst_donor='o''brien';",
+			wantErr:   false,
+		},
+		{
+			name:      "empty donor",
+			donors:    []string{"mariadb-galera-1", ""},
 			wantQuery: "",
 			wantErr:   true,
 		},
 		{
-			name: "missing credentials",
-			options: []ChangeMasterOpt{
-				WithChangeMasterHost("127.0.0.1"),
-				WithChangeMasterPort(3306),
-			},
+			name:      "donor with comma",
+			donors:    []string{"mariadb-galera-1,mariadb-galera-2"},
 			wantQuery: "",
 			wantErr:   true,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := buildSetGaleraDonorQuery(tt.donors, tt.opts...)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query != tt.wantQuery {
+				t.Errorf("unexpected query, want: %s got: %s", tt.wantQuery, query)
+			}
+		})
+	}
+}
+
+func TestEnsureReadOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		readOnly bool
+		err      error
+		wantErr  bool
+	}{
+		{
+			name:     "read-only node",
+			readOnly: true,
+			err:      nil,
+			wantErr:  false,
+		},
 		{
-			name: "valid without SSL",
-			options: []ChangeMasterOpt{
-				WithChangeMasterHost("127.0.0.1"),
-				WithChangeMasterPort(3306),
-				WithChangeMasterCredentials("repl", "password"),
-				WithChangeMasterGtid("CurrentPos"),
+			name:     "writable node",
+			readOnly: false,
+			err:      nil,
+			wantErr:  true,
+		},
+		{
+			name:     "error checking read_only",
+			readOnly: false,
+			err:      errors.New("connection refused"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotVariable string
+			err := ensureReadOnly(context.Background(), func(ctx context.Context, variable string) (bool, error) {
+				gotVariable = variable
+				return tt.readOnly, tt.err
+			})
+			if gotVariable != "read_only" {
+				t.Errorf("unexpected variable, want: read_only got: %s", gotVariable)
+			}
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewReadOnlyClientWithMariaDBHADisabled(t *testing.T) {
+	mdb := &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+		},
+	}
+	if _, err := NewReadOnlyClientWithMariaDB(context.Background(), mdb, nil); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestExecMulti(t *testing.T) {
+	statements := []string{
+		"CREATE USER 'bob'@'%';",
+		"GRANT SELECT ON *.* TO 'bob'@'%';",
+		"GRANT INSERT ON *.* TO 'bob'@'%';",
+	}
+	tests := []struct {
+		name           string
+		failing        map[string]bool
+		opts           []ExecMultiOpt
+		wantExecuted   []string
+		wantErrs       int
+		wantCommitted  bool
+		wantRolledBack bool
+	}{
+		{
+			name:         "all success",
+			failing:      nil,
+			opts:         nil,
+			wantExecuted: statements,
+			wantErrs:     0,
+		},
+		{
+			name: "partial failure",
+			failing: map[string]bool{
+				"GRANT SELECT ON *.* TO 'bob'@'%';": true,
 			},
-			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
-MASTER_HOST='127.0.0.1',
-MASTER_PORT=3306,
-MASTER_USER='repl',
-MASTER_PASSWORD='password',
-MASTER_USE_GTID=CurrentPos,
-MASTER_CONNECT_RETRY=10;
-`,
-			wantErr: false,
+			opts:         nil,
+			wantExecuted: statements,
+			wantErrs:     1,
 		},
 		{
-			name: "missing SSL paths",
-			options: []ChangeMasterOpt{
-				WithChangeMasterHost("127.0.0.1"),
-				WithChangeMasterPort(3306),
-				WithChangeMasterCredentials("repl", "password"),
-				WithChangeMasterSSL("", "", ""),
+			name: "multiple failures",
+			failing: map[string]bool{
+				"CREATE USER 'bob'@'%';":            true,
+				"GRANT INSERT ON *.* TO 'bob'@'%';": true,
 			},
-			wantQuery: "",
-			wantErr:   true,
+			opts:         nil,
+			wantExecuted: statements,
+			wantErrs:     2,
 		},
 		{
-			name: "valid with SSL",
-			options: []ChangeMasterOpt{
-				WithChangeMasterHost("127.0.0.1"),
-				WithChangeMasterPort(3306),
-				WithChangeMasterCredentials("repl", "password"),
-				WithChangeMasterGtid("CurrentPos"),
-				WithChangeMasterSSL("/etc/pki/client.crt", "/etc/pki/client.key", "/etc/pki/ca.crt"),
+			name:          "all success in transaction",
+			failing:       nil,
+			opts:          []ExecMultiOpt{WithTransaction()},
+			wantExecuted:  statements,
+			wantErrs:      0,
+			wantCommitted: true,
+		},
+		{
+			name: "partial failure in transaction",
+			failing: map[string]bool{
+				"GRANT SELECT ON *.* TO 'bob'@'%';": true,
 			},
-			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
-MASTER_HOST='127.0.0.1',
-MASTER_PORT=3306,
-MASTER_USER='repl',
-MASTER_PASSWORD='password',
-MASTER_USE_GTID=CurrentPos,
-MASTER_CONNECT_RETRY=10,
-MASTER_SSL=1,
-MASTER_SSL_CERT='/etc/pki/client.crt',
-MASTER_SSL_KEY='/etc/pki/client.key',
-MASTER_SSL_CA='/etc/pki/ca.crt',
-MASTER_SSL_VERIFY_SERVER_CERT=1;
-`,
-			wantErr: false,
+			opts:           []ExecMultiOpt{WithTransaction()},
+			wantExecuted:   statements[:2],
+			wantErrs:       1,
+			wantRolledBack: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query, err := buildChangeMasterQuery(tt.options...)
+			connector := &fakeExecConnector{
+				failing: tt.failing,
+			}
+			db := sql.OpenDB(connector)
+			defer db.Close()
+			client := &Client{
+				db: db,
+			}
 
-			if tt.wantErr {
-				if err == nil {
-					t.Fatalf("expected error but got nil")
+			err := client.ExecMulti(context.Background(), statements, tt.opts...)
+			if tt.wantErrs == 0 && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErrs > 0 {
+				var multiErr *multierror.Error
+				if !errors.As(err, &multiErr) {
+					t.Fatalf("expected multierror, got: %v", err)
+				}
+				if len(multiErr.Errors) != tt.wantErrs {
+					t.Errorf("unexpected number of errors, want: %d got: %d", tt.wantErrs, len(multiErr.Errors))
 				}
-				return
 			}
-			if err != nil {
-				t.Fatalf("unexpected error: %v", err)
+			if diff := cmp.Diff(tt.wantExecuted, connector.executed); diff != "" {
+				t.Errorf("unexpected executed statements (-want +got):\n%s", diff)
 			}
-
-			if diff := cmp.Diff(query, tt.wantQuery); diff != "" {
-				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			if connector.committed != tt.wantCommitted {
+				t.Errorf("unexpected committed, want: %v got: %v", tt.wantCommitted, connector.committed)
+			}
+			if connector.rolledBack != tt.wantRolledBack {
+				t.Errorf("unexpected rolled back, want: %v got: %v", tt.wantRolledBack, connector.rolledBack)
 			}
 		})
 	}
 }
 
-func TestRequireQuery(t *testing.T) {
+func TestWithMultiStatements(t *testing.T) {
+	dsn, err := BuildDSN(Opts{
+		Host:            "mariadb-0.mariadb-internal",
+		Port:            3306,
+		MultiStatements: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error building DSN: %v", err)
+	}
+	if wantDSN := "tcp(mariadb-0.mariadb-internal:3306)/?multiStatements=true&timeout=5s"; dsn != wantDSN {
+		t.Errorf("unexpected DSN, want: %s got: %s", wantDSN, dsn)
+	}
+
+	opts := Opts{}
+	WithMultiStatements(true)(&opts)
+	if !opts.MultiStatements {
+		t.Error("expected multi statements to be enabled")
+	}
+}
+
+// fakeExecConnector returns connections that record the executed statements, failing the ones configured.
+type fakeExecConnector struct {
+	failing    map[string]bool
+	executed   []string
+	committed  bool
+	rolledBack bool
+}
+
+func (c *fakeExecConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &fakeExecConn{connector: c}, nil
+}
+
+func (c *fakeExecConnector) Driver() driver.Driver {
+	return nil
+}
+
+type fakeExecConn struct {
+	connector *fakeExecConnector
+}
+
+func (c *fakeExecConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (c *fakeExecConn) Close() error {
+	return nil
+}
+
+func (c *fakeExecConn) Begin() (driver.Tx, error) {
+	return &fakeExecTx{connector: c.connector}, nil
+}
+
+func (c *fakeExecConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.connector.executed = append(c.connector.executed, query)
+	if c.connector.failing[query] {
+		return nil, fmt.Errorf("error executing '%s'", query)
+	}
+	return driver.RowsAffected(0), nil
+}
+
+type fakeExecTx struct {
+	connector *fakeExecConnector
+}
+
+func (t *fakeExecTx) Commit() error {
+	t.connector.committed = true
+	return nil
+}
+
+func (t *fakeExecTx) Rollback() error {
+	t.connector.rolledBack = true
+	return nil
+}
+
+func TestClientDB(t *testing.T) {
+	connector := &fakeExecConnector{}
+	client := &Client{
+		db: sql.OpenDB(connector),
+	}
+	defer client.Close()
+
+	db := client.DB()
+	if db == nil {
+		t.Fatal("expected DB to not be nil")
+	}
+	if err := db.PingContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error pinging DB: %v", err)
+	}
+	if _, err := db.ExecContext(context.Background(), "SELECT 1;"); err != nil {
+		t.Fatalf("unexpected error executing query: %v", err)
+	}
+	if diff := cmp.Diff([]string{"SELECT 1;"}, connector.executed); diff != "" {
+		t.Errorf("unexpected executed statements (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuildSetGaleraProviderOptionsQuery(t *testing.T) {
+	current := "base_dir = /var/lib/mysql/; gcache.size = 128M; gcs.fc_limit = 16; gmcast.listen_addr = tcp://0.0.0.0:4567"
 	tests := []struct {
 		name      string
-		require   *mariadbv1alpha1.TLSRequirements
+		current   string
+		opts      map[string]string
 		wantQuery string
 		wantErr   bool
 	}{
 		{
-			name:      "nil",
-			require:   nil,
-			wantQuery: "",
-			wantErr:   true,
-		},
-		{
-			name:      "empty",
-			require:   &mariadbv1alpha1.TLSRequirements{},
+			name:      "no options",
+			current:   current,
+			opts:      nil,
 			wantQuery: "",
-			wantErr:   true,
+			wantErr:   false,
 		},
 		{
-			name: "SSL",
-			require: &mariadbv1alpha1.TLSRequirements{
-				SSL: ptr.To(true),
+			name:    "unchanged options",
+			current: current,
+			opts: map[string]string{
+				"gcache.size":  "128M",
+				"gcs.fc_limit": "16",
 			},
-			wantQuery: "REQUIRE SSL",
+			wantQuery: "",
 			wantErr:   false,
 		},
 		{
-			name: "X509",
-			require: &mariadbv1alpha1.TLSRequirements{
-				X509: ptr.To(true),
+			name:    "changed option",
+			current: current,
+			opts: map[string]string{
+				"gcache.size":  "128M",
+				"gcs.fc_limit": "128",
 			},
-			wantQuery: "REQUIRE X509",
+			wantQuery: "SET @@global.wsrep_provider_options='gcs.fc_limit=128';",
 			wantErr:   false,
 		},
 		{
-			name: "Issuer",
-			require: &mariadbv1alpha1.TLSRequirements{
-				Issuer: ptr.To("/CN=mariadb-galera-ca"),
+			name:    "new and changed options",
+			current: current,
+			opts: map[string]string{
+				"gcs.fc_limit":          "128",
+				"evs.suspect_timeout":   "PT10S",
+				"gmcast.listen_addr":    "tcp://0.0.0.0:4567",
+				"pc.weight":             "2",
+				"gcs.fc_master_slave":   "YES",
+				"evs.inactive_timeout":  "PT30S",
+				"evs.inactive_check_pe": "PT1S",
 			},
-			wantQuery: "REQUIRE ISSUER '/CN=mariadb-galera-ca'",
-			wantErr:   false,
+			wantQuery: "SET @@global.wsrep_provider_options=" +
+				"'evs.inactive_check_pe=PT1S;evs.inactive_timeout=PT30S;evs.suspect_timeout=PT10S;" +
+				"gcs.fc_limit=128;gcs.fc_master_slave=YES;pc.weight=2';",
+			wantErr: false,
 		},
 		{
-			name: "Subject",
-			require: &mariadbv1alpha1.TLSRequirements{
-				Subject: ptr.To("/CN=mariadb-galera-client"),
+			name:    "empty current options",
+			current: "",
+			opts: map[string]string{
+				"gcs.fc_limit": "128",
 			},
-			wantQuery: "REQUIRE SUBJECT '/CN=mariadb-galera-client'",
+			wantQuery: "SET @@global.wsrep_provider_options='gcs.fc_limit=128';",
 			wantErr:   false,
 		},
 		{
-			name: "Issuer and Subject",
-			require: &mariadbv1alpha1.TLSRequirements{
-				Issuer:  ptr.To("/CN=mariadb-galera-ca"),
-				Subject: ptr.To("/CN=mariadb-galera-client"),
+			name:    "invalid key",
+			current: current,
+			opts: map[string]string{
+				"gcs.fc_limit=1;pc.weight": "2",
 			},
-			wantQuery: "REQUIRE ISSUER '/CN=mariadb-galera-ca' AND SUBJECT '/CN=mariadb-galera-client'",
-			wantErr:   false,
+			wantQuery: "",
+			wantErr:   true,
 		},
 		{
-			name: "Multiple",
-			require: &mariadbv1alpha1.TLSRequirements{
-				SSL:     ptr.To(true),
-				X509:    ptr.To(true),
-				Issuer:  ptr.To("/CN=mariadb-galera-ca"),
-				Subject: ptr.To("/CN=mariadb-galera-client"),
+			name:    "invalid value",
+			current: current,
+			opts: map[string]string{
+				"gcs.fc_limit": "128;pc.weight=2",
 			},
 			wantQuery: "",
 			wantErr:   true,
@@ -184,17 +5077,28 @@ func TestRequireQuery(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotQuery, err := requireQuery(tt.require)
-
+			query, err := buildSetGaleraProviderOptionsQuery(tt.current, tt.opts)
 			if tt.wantErr && err == nil {
-				t.Error("expect error to have occurred, got nil")
+				t.Fatal("expected error, got nil")
 			}
 			if !tt.wantErr && err != nil {
-				t.Errorf("expect error to not have occurred, got: %v", err)
+				t.Fatalf("unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(tt.wantQuery, gotQuery); diff != "" {
-				t.Errorf("unexpected bundle content (-want +got):\n%s", diff)
+			if query != tt.wantQuery {
+				t.Errorf("unexpected query, want: %s got: %s", tt.wantQuery, query)
 			}
 		})
 	}
 }
+
+func TestParseGaleraProviderOptions(t *testing.T) {
+	opts := parseGaleraProviderOptions("base_dir = /var/lib/mysql/; gcache.size = 128M;gcs.fc_limit=16; ; invalid")
+	wantOpts := map[string]string{
+		"base_dir":     "/var/lib/mysql/",
+		"gcache.size":  "128M",
+		"gcs.fc_limit": "16",
+	}
+	if diff := cmp.Diff(wantOpts, opts); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+}
@@ -2,14 +2,22 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	"github.com/mariadb-operator/mariadb-operator/pkg/sql"
+	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 )
 
+// healthCheckConcurrency is the maximum number of Pods that are health checked in parallel.
+const healthCheckConcurrency = 5
+
 type ClientSet struct {
 	Mariadb       *mariadbv1alpha1.MariaDB
 	refResolver   *refresolver.RefResolver
@@ -27,6 +35,8 @@ func NewClientSet(mariadb *mariadbv1alpha1.MariaDB, refResolver *refresolver.Ref
 }
 
 func (c *ClientSet) Close() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
 	for i, rc := range c.clientByIndex {
 		if err := rc.Close(); err != nil {
 			return fmt.Errorf("error closing replica '%d' client: %v", i, err)
@@ -39,19 +49,112 @@ func (c *ClientSet) ClientForIndex(ctx context.Context, index int, clientOpts ..
 	if err := c.validateIndex(index); err != nil {
 		return nil, fmt.Errorf("invalid index. %v", err)
 	}
-	if c, ok := c.clientByIndex[index]; ok {
-		return c, nil
+	c.mux.Lock()
+	cachedClient, ok := c.clientByIndex[index]
+	c.mux.Unlock()
+	if ok {
+		return cachedClient, nil
 	}
-	client, err := sql.NewInternalClientWithPodIndex(ctx, c.Mariadb, c.refResolver, index, clientOpts...)
+	// The number of clients is bounded by the replicas, and they are cached until Close. Holding limiter slots for their
+	// whole lifetime could deadlock callers waiting for other Pods, so they are exempted from the client limit.
+	opts := append([]sql.Opt{sql.WithoutClientLimit()}, clientOpts...)
+	client, err := sql.NewInternalClientWithPodIndex(ctx, c.Mariadb, c.refResolver, index, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating replica '%d' client: %v", index, err)
 	}
 	c.mux.Lock()
+	defer c.mux.Unlock()
+	// Clients are created without holding the lock to allow connecting to several Pods concurrently,
+	// therefore another caller may have cached a client for the same index in the meantime.
+	if cachedClient, ok := c.clientByIndex[index]; ok {
+		client.Close()
+		return cachedClient, nil
+	}
 	c.clientByIndex[index] = client
-	c.mux.Unlock()
 	return client, nil
 }
 
+// PrimaryClient returns a client for the Pod designated as primary, determined by PrimaryPodIndex.
+func (c *ClientSet) PrimaryClient(ctx context.Context, primaryEndpoints *corev1.Endpoints, clientOpts ...sql.Opt) (*sql.Client, error) {
+	index, err := PrimaryPodIndex(c.Mariadb, primaryEndpoints)
+	if err != nil {
+		return nil, fmt.Errorf("error getting primary Pod index: %v", err)
+	}
+	return c.ClientForIndex(ctx, index, clientOpts...)
+}
+
+// PrimaryPodIndex returns the index of the Pod designated as primary. Galera has no single primary, but the operator designates one
+// to route the writes through the primary Service. The Pod backing the primary Service Endpoints takes precedence, as it is the one
+// actually receiving the writes, falling back to the primary in the status when the Endpoints are not available.
+func PrimaryPodIndex(mariadb *mariadbv1alpha1.MariaDB, primaryEndpoints *corev1.Endpoints) (int, error) {
+	var pods []string
+	if primaryEndpoints != nil {
+		for _, subset := range primaryEndpoints.Subsets {
+			for _, addr := range subset.Addresses {
+				if addr.TargetRef != nil && addr.TargetRef.Kind == "Pod" {
+					pods = append(pods, addr.TargetRef.Name)
+				}
+			}
+		}
+	}
+
+	switch len(pods) {
+	case 0:
+		if mariadb.Status.CurrentPrimaryPodIndex == nil {
+			return 0, errors.New("primary Pod not found in Endpoints nor in status")
+		}
+		return *mariadb.Status.CurrentPrimaryPodIndex, nil
+	case 1:
+		index, err := statefulset.PodIndex(pods[0])
+		if err != nil {
+			return 0, fmt.Errorf("error getting Pod '%s' index: %v", pods[0], err)
+		}
+		if statefulset.PodName(mariadb.ObjectMeta, *index) != pods[0] {
+			return 0, fmt.Errorf("Pod '%s' does not belong to MariaDB '%s'", pods[0], mariadb.Name)
+		}
+		return *index, nil
+	default:
+		return 0, fmt.Errorf("multiple Pods found in primary Endpoints: %s", strings.Join(pods, ", "))
+	}
+}
+
+// HealthCheckAll pings every Pod concurrently, returning the connectivity error of each Pod index, or nil if it is reachable.
+func (c *ClientSet) HealthCheckAll(ctx context.Context) map[int]error {
+	return healthCheckAll(ctx, int(c.Mariadb.Spec.Replicas), func(ctx context.Context, index int) error {
+		client, err := c.ClientForIndex(ctx, index)
+		if err != nil {
+			return fmt.Errorf("error getting client: %v", err)
+		}
+		if err := client.Ping(ctx); err != nil {
+			return fmt.Errorf("error pinging: %v", err)
+		}
+		return nil
+	})
+}
+
+func healthCheckAll(ctx context.Context, replicas int, healthCheck func(ctx context.Context, index int) error) map[int]error {
+	var (
+		g   errgroup.Group
+		mux sync.Mutex
+	)
+	g.SetLimit(healthCheckConcurrency)
+	result := make(map[int]error, replicas)
+
+	for i := 0; i < replicas; i++ {
+		g.Go(func() error {
+			err := healthCheck(ctx, i)
+
+			mux.Lock()
+			result[i] = err
+			mux.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return result
+}
+
 func (c *ClientSet) validateIndex(index int) error {
 	if index >= 0 && index < int(c.Mariadb.Spec.Replicas) {
 		return nil
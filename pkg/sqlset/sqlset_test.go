@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestHealthCheckAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		replicas    int
+		failing     map[int]bool
+		wantFailing []int
+		wantHealthy []int
+	}{
+		{
+			name:        "no replicas",
+			replicas:    0,
+			failing:     nil,
+			wantFailing: nil,
+			wantHealthy: nil,
+		},
+		{
+			name:        "all healthy",
+			replicas:    3,
+			failing:     nil,
+			wantFailing: nil,
+			wantHealthy: []int{0, 1, 2},
+		},
+		{
+			name:     "mixed",
+			replicas: 3,
+			failing: map[int]bool{
+				1: true,
+			},
+			wantFailing: []int{1},
+			wantHealthy: []int{0, 2},
+		},
+		{
+			name:     "all failing",
+			replicas: 2,
+			failing: map[int]bool{
+				0: true,
+				1: true,
+			},
+			wantFailing: []int{0, 1},
+			wantHealthy: nil,
+		},
+		{
+			name:     "more replicas than concurrency",
+			replicas: 12,
+			failing: map[int]bool{
+				3:  true,
+				7:  true,
+				11: true,
+			},
+			wantFailing: []int{3, 7, 11},
+			wantHealthy: []int{0, 1, 2, 4, 5, 6, 8, 9, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := healthCheckAll(context.Background(), tt.replicas, func(ctx context.Context, index int) error {
+				if tt.failing[index] {
+					return errors.New("connection refused")
+				}
+				return nil
+			})
+
+			if len(result) != tt.replicas {
+				t.Fatalf("unexpected number of results, want: %d got: %d", tt.replicas, len(result))
+			}
+			for _, i := range tt.wantFailing {
+				if err, ok := result[i]; !ok || err == nil {
+					t.Errorf("expected Pod index %d to be failing", i)
+				}
+			}
+			for _, i := range tt.wantHealthy {
+				if err, ok := result[i]; !ok || err != nil {
+					t.Errorf("expected Pod index %d to be healthy, got: %v", i, err)
+				}
+			}
+		})
+	}
+}
+
+func TestHealthCheckAllConcurrency(t *testing.T) {
+	var (
+		mux     sync.Mutex
+		running int
+		peak    int
+	)
+	replicas := 3 * healthCheckConcurrency
+
+	result := healthCheckAll(context.Background(), replicas, func(ctx context.Context, index int) error {
+		mux.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mux.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mux.Lock()
+		running--
+		mux.Unlock()
+		return nil
+	})
+
+	if len(result) != replicas {
+		t.Fatalf("unexpected number of results, want: %d got: %d", replicas, len(result))
+	}
+	if peak > healthCheckConcurrency {
+		t.Errorf("expected at most %d concurrent health checks, got: %d", healthCheckConcurrency, peak)
+	}
+	if peak < 2 {
+		t.Errorf("expected health checks to run concurrently, got peak: %d", peak)
+	}
+}
+
+func TestPrimaryPodIndex(t *testing.T) {
+	objMeta := metav1.ObjectMeta{
+		Name:      "mariadb-galera",
+		Namespace: "default",
+	}
+	tests := []struct {
+		name      string
+		status    mariadbv1alpha1.MariaDBStatus
+		endpoints *corev1.Endpoints
+		wantIndex int
+		wantErr   bool
+	}{
+		{
+			name:      "no Endpoints nor status",
+			status:    mariadbv1alpha1.MariaDBStatus{},
+			endpoints: nil,
+			wantErr:   true,
+		},
+		{
+			name: "status",
+			status: mariadbv1alpha1.MariaDBStatus{
+				CurrentPrimaryPodIndex: ptr.To(1),
+			},
+			endpoints: nil,
+			wantIndex: 1,
+		},
+		{
+			name: "Endpoints without addresses",
+			status: mariadbv1alpha1.MariaDBStatus{
+				CurrentPrimaryPodIndex: ptr.To(1),
+			},
+			endpoints: primaryEndpoints(),
+			wantIndex: 1,
+		},
+		{
+			name: "Endpoints take precedence over status",
+			status: mariadbv1alpha1.MariaDBStatus{
+				CurrentPrimaryPodIndex: ptr.To(1),
+			},
+			endpoints: primaryEndpoints("mariadb-galera-2"),
+			wantIndex: 2,
+		},
+		{
+			name:      "multiple Pods in Endpoints",
+			status:    mariadbv1alpha1.MariaDBStatus{},
+			endpoints: primaryEndpoints("mariadb-galera-0", "mariadb-galera-1"),
+			wantErr:   true,
+		},
+		{
+			name:      "Pod from another MariaDB",
+			status:    mariadbv1alpha1.MariaDBStatus{},
+			endpoints: primaryEndpoints("mariadb-repl-0"),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid Pod name",
+			status:    mariadbv1alpha1.MariaDBStatus{},
+			endpoints: primaryEndpoints("mariadb-galera"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mariadb := &mariadbv1alpha1.MariaDB{
+				ObjectMeta: objMeta,
+				Status:     tt.status,
+			}
+			index, err := PrimaryPodIndex(mariadb, tt.endpoints)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expect error to have occurred, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error getting primary Pod index: %v", err)
+			}
+			if index != tt.wantIndex {
+				t.Errorf("unexpected primary Pod index, want: %d got: %d", tt.wantIndex, index)
+			}
+		})
+	}
+}
+
+func primaryEndpoints(pods ...string) *corev1.Endpoints {
+	var addresses []corev1.EndpointAddress
+	for _, pod := range pods {
+		addresses = append(addresses, corev1.EndpointAddress{
+			IP: "10.244.0.1",
+			TargetRef: &corev1.ObjectReference{
+				Kind:      "Pod",
+				Name:      pod,
+				Namespace: "default",
+			},
+		})
+	}
+	return &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb-galera-primary",
+			Namespace: "default",
+		},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: addresses,
+			},
+		},
+	}
+}
@@ -22,6 +22,26 @@ func PollUntilSucessOrContextCancel(ctx context.Context, logger logr.Logger, fn
 	})
 }
 
+// PollWithBackoffUntilSuccessOrContextCancel waits for the initial delay and then polls until fn succeeds or the context is cancelled,
+// increasing the interval between attempts according to the backoff.
+func PollWithBackoffUntilSuccessOrContextCancel(ctx context.Context, initialDelay time.Duration, backoff kwait.Backoff,
+	logger logr.Logger, fn func(ctx context.Context) error) error {
+	if err := sleep(ctx, initialDelay); err != nil {
+		return err
+	}
+	for {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		logger.V(1).Info("Error polling", "err", err)
+
+		if err := sleep(ctx, backoff.Step()); err != nil {
+			return err
+		}
+	}
+}
+
 func PollWithMariaDB(ctx context.Context, mariadbKey types.NamespacedName, client ctrlclient.Client, logger logr.Logger,
 	fn func(ctx context.Context) error) error {
 	return PollUntilSucessOrContextCancel(ctx, logger, func(ctx context.Context) error {
@@ -32,6 +52,31 @@ func PollWithMariaDB(ctx context.Context, mariadbKey types.NamespacedName, clien
 	})
 }
 
+func PollWithMariaDBAndBackoff(ctx context.Context, mariadbKey types.NamespacedName, client ctrlclient.Client, initialDelay time.Duration,
+	backoff kwait.Backoff, logger logr.Logger, fn func(ctx context.Context) error) error {
+	return PollWithBackoffUntilSuccessOrContextCancel(ctx, initialDelay, backoff, logger, func(ctx context.Context) error {
+		if shouldPoll(ctx, mariadbKey, client, logger) {
+			return fn(ctx)
+		}
+		return nil
+	})
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func shouldPoll(ctx context.Context, mariadbKey types.NamespacedName, client ctrlclient.Client, logger logr.Logger) bool {
 	var mdb mariadbv1alpha1.MariaDB
 	if err := client.Get(ctx, mariadbKey, &mdb); err != nil {
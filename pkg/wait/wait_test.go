@@ -0,0 +1,171 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestPollWithBackoffUntilSuccessOrContextCancel(t *testing.T) {
+	tests := []struct {
+		name          string
+		initialDelay  time.Duration
+		backoff       kwait.Backoff
+		timeout       time.Duration
+		succeedAfter  int
+		wantAttempts  int
+		wantMinDelays []time.Duration
+		wantErr       bool
+	}{
+		{
+			name:         "success on first attempt",
+			initialDelay: 0,
+			backoff: kwait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Factor:   2,
+				Steps:    10,
+			},
+			timeout:       time.Second,
+			succeedAfter:  1,
+			wantAttempts:  1,
+			wantMinDelays: nil,
+			wantErr:       false,
+		},
+		{
+			name:         "initial delay",
+			initialDelay: 50 * time.Millisecond,
+			backoff: kwait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Factor:   2,
+				Steps:    10,
+			},
+			timeout:       time.Second,
+			succeedAfter:  1,
+			wantAttempts:  1,
+			wantMinDelays: []time.Duration{50 * time.Millisecond},
+			wantErr:       false,
+		},
+		{
+			name:         "exponential backoff",
+			initialDelay: 0,
+			backoff: kwait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Factor:   2,
+				Steps:    10,
+			},
+			timeout:      time.Second,
+			succeedAfter: 4,
+			wantAttempts: 4,
+			wantMinDelays: []time.Duration{
+				0,
+				10 * time.Millisecond,
+				20 * time.Millisecond,
+				40 * time.Millisecond,
+			},
+			wantErr: false,
+		},
+		{
+			name:         "capped backoff",
+			initialDelay: 0,
+			backoff: kwait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Factor:   4,
+				Steps:    10,
+				Cap:      20 * time.Millisecond,
+			},
+			timeout:      time.Second,
+			succeedAfter: 5,
+			wantAttempts: 5,
+			wantMinDelays: []time.Duration{
+				0,
+				10 * time.Millisecond,
+				20 * time.Millisecond,
+				20 * time.Millisecond,
+				20 * time.Millisecond,
+			},
+			wantErr: false,
+		},
+		{
+			name:         "steps exhausted",
+			initialDelay: 0,
+			backoff: kwait.Backoff{
+				Duration: 5 * time.Millisecond,
+				Factor:   1,
+				Steps:    1,
+			},
+			timeout:       time.Second,
+			succeedAfter:  5,
+			wantAttempts:  5,
+			wantMinDelays: nil,
+			wantErr:       false,
+		},
+		{
+			name:         "timeout",
+			initialDelay: 0,
+			backoff: kwait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Factor:   2,
+				Steps:    10,
+			},
+			timeout:       100 * time.Millisecond,
+			succeedAfter:  -1,
+			wantAttempts:  -1,
+			wantMinDelays: nil,
+			wantErr:       true,
+		},
+		{
+			name:         "initial delay longer than timeout",
+			initialDelay: time.Second,
+			backoff: kwait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Factor:   2,
+				Steps:    10,
+			},
+			timeout:       50 * time.Millisecond,
+			succeedAfter:  1,
+			wantAttempts:  0,
+			wantMinDelays: nil,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			var attempts []time.Time
+			start := time.Now()
+			err := PollWithBackoffUntilSuccessOrContextCancel(ctx, tt.initialDelay, tt.backoff, logr.Discard(),
+				func(ctx context.Context) error {
+					attempts = append(attempts, time.Now())
+					if tt.succeedAfter > 0 && len(attempts) >= tt.succeedAfter {
+						return nil
+					}
+					return errors.New("not ready")
+				},
+			)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantAttempts >= 0 && len(attempts) != tt.wantAttempts {
+				t.Fatalf("unexpected number of attempts, want: %d got: %d", tt.wantAttempts, len(attempts))
+			}
+
+			previous := start
+			for i, minDelay := range tt.wantMinDelays {
+				if delay := attempts[i].Sub(previous); delay < minDelay {
+					t.Errorf("unexpected delay before attempt %d, want at least: %v got: %v", i, minDelay, delay)
+				}
+				previous = attempts[i]
+			}
+		})
+	}
+}